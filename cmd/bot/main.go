@@ -6,9 +6,14 @@ import (
 	"os"
 
 	"servbot/internal/adapters/discord"
+	"servbot/internal/adapters/httpserver"
+	"servbot/internal/application"
 	"servbot/internal/config"
 	"servbot/internal/infrastructure/database"
 	"servbot/internal/infrastructure/database/sqlc_generated"
+	"servbot/internal/infrastructure/eventbus"
+	appi18n "servbot/internal/infrastructure/i18n"
+	"servbot/internal/infrastructure/webhook"
 )
 
 func main() {
@@ -33,10 +38,36 @@ func main() {
 	defer pool.Close()
 
 	q := sqlc_generated.New(pool)
+	if cfg.GuildID != "" {
+		if err := database.BackfillGuildID(ctx, q, cfg.GuildID); err != nil {
+			log.Fatalf("❌ Erreur lors de l'attribution du serveur aux événements existants: %v", err)
+		}
+	}
 	eventRepo := database.NewEventRepository(q)
 	participantRepo := database.NewParticipantRepository(q)
+	seriesRepo := database.NewSeriesRepository(q)
+	questionRepo := database.NewQuestionRepository(q)
+	guildSettingsRepo := database.NewGuildSettingsRepository(q)
+	userPrefsRepo := database.NewUserPreferencesRepository(q)
+	webhookRepo := database.NewWebhookSubscriptionRepository(q)
+	uow := database.NewUnitOfWork(pool)
+	bus := eventbus.New()
+	bus.Subscribe(webhook.NewPublisher(webhookRepo).HandleDomainEvent)
+
+	if cfg.HTTPAddr != "" {
+		server := httpserver.NewServer(
+			cfg.HTTPAddr,
+			cfg.APIToken,
+			application.NewEventService(eventRepo, participantRepo, seriesRepo, uow, bus),
+			application.NewParticipantService(participantRepo, eventRepo, uow, guildSettingsRepo, appi18n.NewTranslator("fr"), bus),
+			application.NewCalendarService(eventRepo, userPrefsRepo),
+			application.NewWebhookService(webhookRepo),
+		)
+		server.Start()
+		defer server.Shutdown()
+	}
 
-	bot := discord.NewBot(cfg, eventRepo, participantRepo)
+	bot := discord.NewBot(cfg, eventRepo, participantRepo, seriesRepo, questionRepo, guildSettingsRepo, userPrefsRepo, webhookRepo, uow, bus)
 	if err := bot.Start(); err != nil {
 		log.Printf("❌ Erreur lors du démarrage du bot: %v", err)
 		os.Exit(1)
@@ -1,6 +1,7 @@
 package discord
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -12,6 +13,10 @@ import (
 
 	"servbot/internal/application"
 	"servbot/internal/config"
+	"servbot/internal/domain"
+	"servbot/internal/domain/domainevent"
+	"servbot/internal/domain/entities"
+	"servbot/internal/infrastructure/eventbus"
 	appi18n "servbot/internal/infrastructure/i18n"
 	"servbot/internal/ports/output"
 )
@@ -23,19 +28,26 @@ type Bot struct {
 }
 
 // NewBot wires output adapters, application services, and handler (composition root).
-func NewBot(cfg *config.Config, eventRepo output.EventRepository, participantRepo output.ParticipantRepository) *Bot {
+func NewBot(cfg *config.Config, eventRepo output.EventRepository, participantRepo output.ParticipantRepository, seriesRepo output.SeriesRepository, questionRepo output.QuestionRepository, guildSettingsRepo output.GuildSettingsRepository, userPrefsRepo output.UserPreferencesRepository, webhookRepo output.WebhookSubscriptionRepository, uow output.UnitOfWork, bus *eventbus.Bus) *Bot {
 	defaultLocale := "fr"
 	translator := appi18n.NewTranslator(defaultLocale)
 
-	eventUC := application.NewEventService(eventRepo, participantRepo)
-	participantUC := application.NewParticipantService(participantRepo, eventRepo, translator)
+	eventUC := application.NewEventService(eventRepo, participantRepo, seriesRepo, uow, bus)
+	participantUC := application.NewParticipantService(participantRepo, eventRepo, uow, guildSettingsRepo, translator, bus)
+	questionUC := application.NewQuestionService(questionRepo, eventRepo)
+	guildSettingsUC := application.NewGuildSettingsService(guildSettingsRepo, eventRepo, seriesRepo, webhookRepo)
+	userPrefsUC := application.NewUserPreferencesService(userPrefsRepo)
+	calendarUC := application.NewCalendarService(eventRepo, userPrefsRepo)
 
 	s, err := discordgo.New("Bot " + cfg.Token)
 	if err != nil {
 		log.Fatal("❌ Erreur lors de la création de la session Discord:", err)
 	}
 
-	handler := NewHandler(eventUC, participantUC, translator, cfg.ForumChannelID, cfg.GuildID, defaultLocale)
+	handler := NewHandler(eventUC, participantUC, questionUC, guildSettingsUC, userPrefsUC, calendarUC, translator, cfg.PublicURL)
+	bus.Subscribe(func(ctx context.Context, e domainevent.Event) {
+		handler.HandleDomainEvent(s, ctx, e)
+	})
 
 	bot := &Bot{
 		session: s,
@@ -50,6 +62,12 @@ func (b *Bot) setupHandlers() {
 	b.session.AddHandler(b.handleInteraction)
 	b.session.AddHandler(b.handleMessageReactionAdd)
 	b.session.AddHandler(b.handleMessageReactionRemove)
+	b.session.AddHandler(b.handleGuildCreate)
+	b.session.AddHandler(b.handleGuildDelete)
+	b.session.AddHandler(b.handleGuildScheduledEventUpdate)
+	b.session.AddHandler(b.handleGuildScheduledEventDelete)
+	b.session.AddHandler(b.handleGuildScheduledEventUserAdd)
+	b.session.AddHandler(b.handleGuildScheduledEventUserRemove)
 }
 
 func (b *Bot) handleInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
@@ -63,10 +81,28 @@ func (b *Bot) handleInteraction(s *discordgo.Session, i *discordgo.InteractionCr
 			b.handler.HandleTemplateCommand(s, i)
 		case "retirer":
 			b.handler.HandleRemoveCommand(s, i)
+		case "annuler":
+			b.handler.HandleCancelCommand(s, i)
+		case "questions":
+			b.handler.HandleQuestionsCommand(s, i)
+		case "config":
+			b.handler.HandleConfigCommand(s, i)
+		case "langue":
+			b.handler.HandleLocaleCommand(s, i)
+		case "calendrier":
+			b.handler.HandleCalendarCommand(s, i)
+		case "mes-sorties":
+			b.handler.HandleMyEventsCommand(s, i)
+		case "acces":
+			b.handler.HandleRolesCommand(s, i)
+		case "cohote-ajouter":
+			b.handler.HandleAddCoHostCommand(s, i)
+		case "cohote-retirer":
+			b.handler.HandleRemoveCoHostCommand(s, i)
 		}
 	case discordgo.InteractionModalSubmit:
 		modalData := i.ModalSubmitData()
-		if modalData.CustomID == "edit_event_modal" {
+		if strings.HasPrefix(modalData.CustomID, "edit_event_modal") || strings.HasPrefix(modalData.CustomID, "edit_series_modal_") {
 			b.handler.HandleEditModalSubmit(s, i)
 		} else {
 			b.handler.HandleModalSubmit(s, i)
@@ -101,6 +137,28 @@ func (b *Bot) handleInteraction(s *discordgo.Session, i *discordgo.InteractionCr
 				b.handler.HandleWaitlistSlotAccept(s, i)
 			case strings.HasPrefix(customID, "btn_waitlist_slot_ignore_"):
 				b.handler.HandleWaitlistSlotIgnore(s, i)
+			case strings.HasPrefix(customID, "btn_offer_accept_"):
+				b.handler.HandleSlotOfferAccept(s, i)
+			case strings.HasPrefix(customID, "btn_offer_decline_"):
+				b.handler.HandleSlotOfferDecline(s, i)
+			case strings.HasPrefix(customID, "btn_guests_"):
+				b.handler.HandleGuests(s, i)
+			case strings.HasPrefix(customID, "btn_my_leave_"):
+				b.handler.HandleMyEventsLeave(s, i)
+			case strings.HasPrefix(customID, "btn_my_switch_"):
+				b.handler.HandleMyEventsSwitch(s, i)
+			case customID == "btn_my_back":
+				b.handler.HandleMyEventsBack(s, i)
+			case strings.HasPrefix(customID, "btn_manage_series_"):
+				b.handler.HandleManageSeries(s, i)
+			case strings.HasPrefix(customID, "btn_series_edit_"):
+				b.handler.HandleSeriesEditScope(s, i)
+			case strings.HasPrefix(customID, "btn_series_skip_"):
+				b.handler.HandleSeriesSkip(s, i)
+			case strings.HasPrefix(customID, "btn_series_stop_"):
+				b.handler.HandleSeriesStop(s, i)
+			case strings.HasPrefix(customID, "btn_cancel_event_"):
+				b.handler.HandleCancelEvent(s, i)
 			}
 		} else {
 			switch {
@@ -108,6 +166,12 @@ func (b *Bot) handleInteraction(s *discordgo.Session, i *discordgo.InteractionCr
 				b.handler.HandleRemoveUserSelect(s, i)
 			case strings.HasPrefix(customID, "select_promote"):
 				b.handler.HandlePromote(s, i)
+			case strings.HasPrefix(customID, "select_event_roles_"):
+				b.handler.HandleEventRolesSelect(s, i)
+			case strings.HasPrefix(customID, "select_guests_"):
+				b.handler.HandleGuestsSelect(s, i)
+			case strings.HasPrefix(customID, "select_my_switch_"):
+				b.handler.HandleMyEventsSwitchSelect(s, i)
 			}
 		}
 	}
@@ -121,7 +185,11 @@ func (b *Bot) handleMessageReactionAdd(s *discordgo.Session, r *discordgo.Messag
 	if displayName == "" {
 		displayName = r.UserID
 	}
-	b.handler.HandleReactionJoin(s, r.ChannelID, r.MessageID, r.UserID, displayName)
+	var roleIDs []string
+	if r.Member != nil {
+		roleIDs = r.Member.Roles
+	}
+	b.handler.HandleReactionJoin(s, r.ChannelID, r.MessageID, r.UserID, displayName, roleIDs)
 }
 
 func (b *Bot) handleMessageReactionRemove(s *discordgo.Session, r *discordgo.MessageReactionRemove) {
@@ -131,6 +199,28 @@ func (b *Bot) handleMessageReactionRemove(s *discordgo.Session, r *discordgo.Mes
 	b.handler.HandleReactionLeave(s, r.ChannelID, r.MessageID, r.UserID)
 }
 
+func (b *Bot) handleGuildScheduledEventUpdate(s *discordgo.Session, e *discordgo.GuildScheduledEventUpdate) {
+	b.handler.HandleScheduledEventUpdate(s, e.GuildScheduledEvent)
+}
+
+func (b *Bot) handleGuildScheduledEventDelete(s *discordgo.Session, e *discordgo.GuildScheduledEventDelete) {
+	b.handler.HandleScheduledEventDelete(s, e.GuildScheduledEvent)
+}
+
+func (b *Bot) handleGuildScheduledEventUserAdd(s *discordgo.Session, e *discordgo.GuildScheduledEventUserAdd) {
+	if e.UserID == s.State.User.ID {
+		return
+	}
+	b.handler.HandleScheduledEventUserAdd(s, e.GuildID, e.GuildScheduledEventID, e.UserID)
+}
+
+func (b *Bot) handleGuildScheduledEventUserRemove(s *discordgo.Session, e *discordgo.GuildScheduledEventUserRemove) {
+	if e.UserID == s.State.User.ID {
+		return
+	}
+	b.handler.HandleScheduledEventUserRemove(s, e.GuildScheduledEventID, e.UserID)
+}
+
 func (b *Bot) deleteAllCommands(appID, guildID string) {
 	scope := "global"
 	if guildID != "" {
@@ -148,46 +238,216 @@ func (b *Bot) deleteAllCommands(appID, guildID string) {
 	}
 }
 
-func (b *Bot) Start() error {
-	if err := b.session.Open(); err != nil {
-		return fmt.Errorf("erreur lors de l'ouverture de la session: %w", err)
-	}
-	defer b.session.Close()
+var (
+	manageGuildPermission int64   = discordgo.PermissionManageGuild
+	minOfferDelayHours    float64 = 1
+)
 
-	appID := b.session.State.User.ID
-	targetGuildID := b.config.GuildID
-	b.deleteAllCommands(appID, "")
-	if targetGuildID != "" {
-		b.deleteAllCommands(appID, targetGuildID)
+// seedGuildSettings imports the former FORUM_CHANNEL_ID setup into the settings of its guild.
+func (b *Bot) seedGuildSettings() {
+	if b.config.ForumChannelID == "" {
+		return
+	}
+	guildID := b.config.GuildID
+	if guildID == "" {
+		guildID = channelGuildID(b.session, b.config.ForumChannelID)
+	}
+	if guildID == "" {
+		log.Printf("⚠️ FORUM_CHANNEL_ID ignoré : serveur du forum introuvable")
+		return
 	}
+	if err := b.handler.guildSettingsUseCase.SeedGuildSettings(context.Background(), guildID, b.config.ForumChannelID); err != nil {
+		log.Printf("⚠️ Import de FORUM_CHANNEL_ID dans les paramètres du serveur: %v", err)
+	}
+}
 
-	commands := []*discordgo.ApplicationCommand{
+// commands returns the slash commands, described in locale.
+func (b *Bot) commands(locale string) []*discordgo.ApplicationCommand {
+	t := func(key string) string { return b.handler.translator.T(locale, key, nil) }
+	return []*discordgo.ApplicationCommand{
 		{
 			Name:        "sortie",
-			Description: b.handler.translate("cmd.sortie.description", nil),
+			Description: t("cmd.sortie.description"),
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "repetition",
+					Description: t("cmd.sortie.option_recurrence"),
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: t("cmd.sortie.recurrence_weekly"), Value: domain.RecurrenceWeekly},
+						{Name: t("cmd.sortie.recurrence_monthly"), Value: domain.RecurrenceMonthly},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "fuseau",
+					Description: t("cmd.sortie.option_timezone"),
+				},
+			},
 		},
 		{
 			Name:        "sortie-template",
-			Description: b.handler.translate("cmd.sortie_template.description", nil),
+			Description: t("cmd.sortie_template.description"),
 		},
 		{
 			Name:        "retirer",
-			Description: b.handler.translate("cmd.retirer.description", nil),
+			Description: t("cmd.retirer.description"),
+		},
+		{
+			Name:        "annuler",
+			Description: t("cmd.annuler.description"),
+		},
+		{
+			Name:        "acces",
+			Description: t("cmd.acces.description"),
+		},
+		{
+			Name:        "cohote-ajouter",
+			Description: t("cmd.cohote_ajouter.description"),
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "membre",
+					Description: t("cmd.cohote.member_description"),
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name:        "cohote-retirer",
+			Description: t("cmd.cohote_retirer.description"),
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "membre",
+					Description: t("cmd.cohote.member_description"),
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name:        "questions",
+			Description: t("cmd.questions.description"),
+		},
+		{
+			Name:        "langue",
+			Description: t("cmd.langue.description"),
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "langue",
+					Description: t("cmd.langue.option_locale"),
+					Required:    true,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Français", Value: domain.LocaleFR},
+						{Name: "English", Value: domain.LocaleEN},
+					},
+				},
+			},
+		},
+		{
+			Name:        "calendrier",
+			Description: t("cmd.calendrier.description"),
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "renouveler",
+					Description: t("cmd.calendrier.option_renew"),
+				},
+			},
+		},
+		{
+			Name:        "mes-sorties",
+			Description: t("cmd.mes_sorties.description"),
+		},
+		{
+			Name:                     "config",
+			Description:              t("cmd.config.description"),
+			DefaultMemberPermissions: &manageGuildPermission,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionChannel,
+					Name:         "forum",
+					Description:  t("cmd.config.option_forum"),
+					ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildForum},
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionChannel,
+					Name:         "categorie",
+					Description:  t("cmd.config.option_category"),
+					ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildCategory},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "langue",
+					Description: t("cmd.config.option_locale"),
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Français", Value: domain.LocaleFR},
+						{Name: "English", Value: domain.LocaleEN},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "fuseau",
+					Description: t("cmd.config.option_timezone"),
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "attente",
+					Description: t("cmd.config.option_waitlist"),
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: t("ui.config_waitlist_auto"), Value: "auto"},
+						{Name: t("ui.config_waitlist_manual"), Value: "manual"},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "delai_offre",
+					Description: t("cmd.config.option_offer_delay"),
+					MinValue:    &minOfferDelayHours,
+					MaxValue:    entities.MaxOfferDelayHours,
+				},
+			},
 		},
 	}
+}
 
-	// Si GUILD_ID est défini, on enregistre les commandes au niveau du serveur
-	// pour qu'elles soient disponibles immédiatement (pratique pour le debug).
-	// Sinon, fallback sur des commandes globales (peuvent prendre plusieurs minutes).
-	for _, cmd := range commands {
-		if _, err := b.session.ApplicationCommandCreate(appID, targetGuildID, cmd); err != nil {
-			scope := "global"
-			if targetGuildID != "" {
-				scope = "guild"
-			}
-			log.Printf("⚠️ Erreur lors de l'enregistrement de la commande %s (%s): %v", cmd.Name, scope, err)
-		}
+// handleGuildCreate registers the commands of each guild the bot is in, in the guild's language.
+// If GUILD_ID is set, only that guild gets them (handy for debugging).
+func (b *Bot) handleGuildCreate(s *discordgo.Session, g *discordgo.GuildCreate) {
+	if g.Unavailable || (b.config.GuildID != "" && g.ID != b.config.GuildID) {
+		return
+	}
+	settings := b.handler.guildSettings(context.Background(), g.ID)
+	if _, err := s.ApplicationCommandBulkOverwrite(s.State.User.ID, g.ID, b.commands(settings.Locale)); err != nil {
+		log.Printf("⚠️ Erreur lors de l'enregistrement des commandes (guild %s): %v", g.ID, err)
 	}
+}
+
+// handleGuildDelete forgets a guild the bot was removed from. Unavailable guilds are
+// only temporarily unreachable (Discord outage) and are kept.
+func (b *Bot) handleGuildDelete(s *discordgo.Session, g *discordgo.GuildDelete) {
+	if g.Unavailable {
+		return
+	}
+	if err := b.handler.guildSettingsUseCase.RemoveGuild(context.Background(), g.ID); err != nil {
+		log.Printf("❌ Suppression des données du serveur %s: %v", g.ID, err)
+		return
+	}
+	log.Printf("🧹 Données du serveur %s supprimées", g.ID)
+}
+
+func (b *Bot) Start() error {
+	if err := b.session.Open(); err != nil {
+		return fmt.Errorf("erreur lors de l'ouverture de la session: %w", err)
+	}
+	defer b.session.Close()
+
+	appID := b.session.State.User.ID
+	b.seedGuildSettings()
+	// Les commandes sont enregistrées par serveur à la réception de GuildCreate (voir handleGuildCreate) ;
+	// on supprime celles qui avaient été enregistrées globalement.
+	b.deleteAllCommands(appID, "")
 
 	go b.handler.RunScheduledTasks(b.session)
 
@@ -0,0 +1,95 @@
+package discord
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"servbot/internal/domain/entities"
+	"servbot/pkg/ical"
+)
+
+const calendarExportFileName = "servbot.ics"
+
+// eventICSFile is the .ics attachment of a single event, nil when it has no date.
+func eventICSFile(event *entities.Event) *discordgo.File {
+	if event.ScheduledAt.IsZero() {
+		return nil
+	}
+	return &discordgo.File{
+		Name:        ical.FileName(event),
+		ContentType: ical.ContentType,
+		Reader:      strings.NewReader(ical.Calendar(event.Title, []entities.Event{*event})),
+	}
+}
+
+// sendDMWithFile sends content in the DMs of userID with an optional attachment.
+func sendDMWithFile(s *discordgo.Session, userID, content string, file *discordgo.File) {
+	if file == nil {
+		sendDM(s, userID, content)
+		return
+	}
+	ch, _ := s.UserChannelCreate(userID)
+	if ch != nil {
+		_, _ = s.ChannelMessageSendComplex(ch.ID, &discordgo.MessageSend{
+			Content: content,
+			Files:   []*discordgo.File{file},
+		})
+	}
+}
+
+// calendarFeedURL is the address served by the HTTP adapter for the feed token.
+func (h *Handler) calendarFeedURL(token string) string {
+	return h.publicURL + "/calendar/" + token + ".ics"
+}
+
+// HandleCalendarCommand replies with an .ics of the member's upcoming outings (/calendrier),
+// plus the URL of their iCal feed when the HTTP server is enabled.
+func (h *Handler) HandleCalendarCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	userID := interactionUserID(i)
+	renew := false
+	for _, opt := range i.ApplicationCommandData().Options {
+		if opt.Name == "renouveler" {
+			renew = opt.BoolValue()
+		}
+	}
+
+	events, err := h.calendarUseCase.UpcomingEvents(ctx, userID)
+	if err != nil {
+		log.Printf("❌ Export de l'agenda (user %s): %v", userID, err)
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.generic", nil))
+		return
+	}
+
+	data := &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral}
+	if len(events) == 0 {
+		data.Content = h.translateFor(i, "info.calendar_empty", nil)
+	} else {
+		data.Content = h.translateFor(i, "info.calendar_export", map[string]any{"Count": len(events)})
+		data.Files = []*discordgo.File{{
+			Name:        calendarExportFileName,
+			ContentType: ical.ContentType,
+			Reader:      strings.NewReader(ical.Calendar("servBot", events)),
+		}}
+	}
+
+	if h.publicURL != "" {
+		token, err := h.calendarUseCase.FeedToken(ctx, userID, renew)
+		if err != nil {
+			log.Printf("❌ Lien d'abonnement à l'agenda (user %s): %v", userID, err)
+		} else {
+			data.Content += h.translateFor(i, "info.calendar_feed", map[string]any{"URL": h.calendarFeedURL(token)})
+			if renew {
+				data.Content += h.translateFor(i, "info.calendar_feed_renewed", nil)
+			}
+		}
+	}
+
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: data,
+	})
+}
@@ -0,0 +1,147 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func (h *Handler) respondCancelEventModal(s *discordgo.Session, i *discordgo.InteractionCreate, event *entities.Event) {
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: fmt.Sprintf("cancel_event_modal_%d", event.ID),
+			Title:    h.translateFor(i, "ui.modal_cancel_event_title", nil),
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+					discordgo.TextInput{
+						CustomID:    "reason",
+						Label:       h.translateFor(i, "ui.modal_cancel_event_label", nil),
+						Style:       discordgo.TextInputParagraph,
+						Required:    true,
+						MaxLength:   500,
+						Placeholder: h.translateFor(i, "ui.modal_cancel_event_placeholder", nil),
+					},
+				}},
+			},
+		},
+	})
+}
+
+// checkCancellable answers the interaction and returns false when event cannot be cancelled by the user.
+func (h *Handler) checkCancellable(s *discordgo.Session, i *discordgo.InteractionCreate, event *entities.Event) bool {
+	switch {
+	case !h.eventUseCase.CanManageEvent(event, interactionUserID(i)):
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.only_organizer_can_cancel", nil))
+	case event.IsCancelled():
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_cancelled", nil))
+	case event.HasStarted():
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_started", nil))
+	default:
+		return true
+	}
+	return false
+}
+
+// HandleCancelEvent is triggered by the embed "Annuler" button.
+func (h *Handler) HandleCancelEvent(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	event, err := h.eventUseCase.GetEventByMessageID(context.Background(), i.Message.ID)
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_not_found", nil))
+		return
+	}
+	if h.checkCancellable(s, i, event) {
+		h.respondCancelEventModal(s, i, event)
+	}
+}
+
+// HandleCancelCommand is triggered by the /annuler slash command from the private channel.
+func (h *Handler) HandleCancelCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	event, err := h.eventUseCase.GetEventByPrivateChannelID(context.Background(), i.ChannelID)
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.cancel_command_wrong_channel", nil))
+		return
+	}
+	if h.checkCancellable(s, i, event) {
+		h.respondCancelEventModal(s, i, event)
+	}
+}
+
+// handleCancelEventModalSubmit cancels the event, notifies its participants and closes its Discord resources.
+func (h *Handler) handleCancelEventModalSubmit(s *discordgo.Session, i *discordgo.InteractionCreate, data discordgo.ModalSubmitInteractionData) {
+	eventID, err := strconv.ParseUint(strings.TrimPrefix(data.CustomID, "cancel_event_modal_"), 10, 32)
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_not_found", nil))
+		return
+	}
+	reason := strings.TrimSpace(extractTextInputValue(data, "reason"))
+
+	// Acknowledge immediately: notifying participants can exceed the 3-second timeout.
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
+	})
+
+	ctx := context.Background()
+	if _, err := h.eventUseCase.CancelEvent(ctx, uint(eventID), interactionUserID(i), reason); err != nil {
+		key := "errors.generic"
+		switch {
+		case errors.Is(err, domain.ErrNotOrganizer):
+			key = "errors.only_organizer_can_cancel"
+		case errors.Is(err, domain.ErrEventNotFound), errors.Is(err, domain.ErrEventCancelled), errors.Is(err, domain.ErrEventStarted):
+			key = "errors." + domain.Code(err)
+		default:
+			log.Printf("❌ Annulation de la sortie (event %d): %v", eventID, err)
+		}
+		s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+			Content: h.translateFor(i, key, nil),
+			Flags:   discordgo.MessageFlagsEphemeral,
+		})
+		return
+	}
+
+	s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+		Content: h.translateFor(i, "success.event_cancelled", nil),
+		Flags:   discordgo.MessageFlagsEphemeral,
+	})
+}
+
+func closeThread(s *discordgo.Session, threadID string) {
+	if threadID == "" {
+		return
+	}
+	locked, archived := true, true
+	if _, err := s.ChannelEditComplex(threadID, &discordgo.ChannelEdit{Locked: &locked, Archived: &archived}); err != nil {
+		log.Printf("❌ Fermeture du thread %s: %v", threadID, err)
+	}
+}
+
+// lockPrivateChannel keeps read access for every member of the private channel but removes the right to write.
+func lockPrivateChannel(s *discordgo.Session, channelID, botID string) {
+	if channelID == "" {
+		return
+	}
+	ch, err := s.Channel(channelID)
+	if err != nil || ch == nil {
+		log.Printf("❌ Verrouillage salon privé (channel=%s): %v", channelID, err)
+		return
+	}
+	for _, ow := range ch.PermissionOverwrites {
+		if ow.Type != discordgo.PermissionOverwriteTypeMember || ow.ID == botID {
+			continue
+		}
+		err := s.ChannelPermissionSet(channelID, ow.ID, discordgo.PermissionOverwriteTypeMember,
+			discordgo.PermissionViewChannel, discordgo.PermissionSendMessages)
+		if err != nil {
+			log.Printf("❌ Verrouillage salon privé (channel=%s, user=%s): %v", channelID, ow.ID, err)
+		}
+	}
+}
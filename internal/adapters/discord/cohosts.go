@@ -0,0 +1,125 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/bwmarrin/discordgo"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+)
+
+// sendToHosts runs send for the organizer and each co-host. It fails only when no host got the
+// DM: a host whose DMs are closed is logged, and callers marking the DM as sent do not send it
+// again to the others.
+func sendToHosts(eventID uint, creatorID string, coHostIDs []string, send func(hostID string) error) error {
+	var errs []error
+	for _, hostID := range append([]string{creatorID}, coHostIDs...) {
+		if err := send(hostID); err != nil {
+			errs = append(errs, fmt.Errorf("host %s: %w", hostID, err))
+		}
+	}
+	if len(errs) == len(coHostIDs)+1 {
+		return errors.Join(errs...)
+	}
+	for _, err := range errs {
+		log.Printf("⚠️ MP organisateur (event %d): %v", eventID, err)
+	}
+	return nil
+}
+
+// HandleAddCoHostCommand is triggered by the /cohote-ajouter slash command from the private channel.
+func (h *Handler) HandleAddCoHostCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	h.handleCoHostCommand(s, i, true)
+}
+
+// HandleRemoveCoHostCommand is triggered by the /cohote-retirer slash command from the private channel.
+func (h *Handler) HandleRemoveCoHostCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	h.handleCoHostCommand(s, i, false)
+}
+
+func (h *Handler) handleCoHostCommand(s *discordgo.Session, i *discordgo.InteractionCreate, add bool) {
+	ctx := context.Background()
+	event, err := h.eventUseCase.GetEventByPrivateChannelID(ctx, i.ChannelID)
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.remove_command_wrong_channel", nil))
+		return
+	}
+	var member *discordgo.User
+	for _, opt := range i.ApplicationCommandData().Options {
+		if opt.Name == "membre" {
+			member = opt.UserValue(nil)
+		}
+	}
+	if member == nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.invalid_cohost", nil))
+		return
+	}
+	if resolved := i.ApplicationCommandData().Resolved; resolved != nil {
+		if u, ok := resolved.Users[member.ID]; ok && u.Bot {
+			respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.invalid_cohost", nil))
+			return
+		}
+	}
+
+	userID := interactionUserID(i)
+	key := "success.cohost_added"
+	if add {
+		_, err = h.eventUseCase.AddCoHost(ctx, event.ID, userID, member.ID)
+	} else {
+		key = "success.cohost_removed"
+		_, err = h.eventUseCase.RemoveCoHost(ctx, event.ID, userID, member.ID)
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotOrganizer):
+			key = "errors.only_organizer_can_delegate"
+		case errors.Is(err, domain.ErrAlreadyCoHost), errors.Is(err, domain.ErrCoHostNotFound),
+			errors.Is(err, domain.ErrInvalidCoHost), errors.Is(err, domain.ErrEventNotFound),
+			errors.Is(err, domain.ErrEventCancelled):
+			key = "errors." + domain.Code(err)
+		default:
+			log.Printf("❌ Co-organisateurs (event %d): %v", event.ID, err)
+			key = "errors.generic"
+		}
+	}
+	respondEphemeral(s, i.Interaction, h.translateFor(i, key, map[string]any{"Mention": "<@" + member.ID + ">"}))
+}
+
+// onCoHostAdded opens the private channel and the Questions thread to the new co-host.
+func (h *Handler) onCoHostAdded(s *discordgo.Session, ctx context.Context, event *entities.Event, userID string) {
+	grantPrivateChannelAccess(s, event.PrivateChannelID, userID)
+	if event.QuestionsThreadID != "" {
+		_ = s.ThreadMemberAdd(event.QuestionsThreadID, userID)
+	}
+	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
+	sendDM(s, userID, h.translateIn(h.userLocale(ctx, userID), "dm.cohost_added", map[string]any{
+		"EventTitle": event.Title,
+		"Organizer":  "<@" + event.CreatorID + ">",
+	}))
+}
+
+// onCoHostRemoved closes the private channel again, unless the former co-host has a confirmed
+// place in a finalized event, and removes them from the Questions thread.
+func (h *Handler) onCoHostRemoved(s *discordgo.Session, ctx context.Context, event *entities.Event, userID string) {
+	if !event.IsFinalized() || !isConfirmedParticipant(event, userID) {
+		revokePrivateChannelAccess(s, event.PrivateChannelID, userID)
+	}
+	if event.QuestionsThreadID != "" {
+		_ = s.ThreadMemberRemove(event.QuestionsThreadID, userID)
+	}
+	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
+	sendDM(s, userID, h.translateIn(h.userLocale(ctx, userID), "dm.cohost_removed", map[string]any{"EventTitle": event.Title}))
+}
+
+func isConfirmedParticipant(event *entities.Event, userID string) bool {
+	for _, p := range event.Participants {
+		if p.UserID == userID {
+			return p.Status == domain.StatusConfirmed
+		}
+	}
+	return false
+}
@@ -1,59 +1,101 @@
 package discord
 
 import (
+	"context"
+	"strings"
+
 	"github.com/bwmarrin/discordgo"
+
+	"servbot/pkg/tz"
 )
 
+// maxLocationLength is the limit Discord puts on a scheduled event location.
+const maxLocationLength = 100
+
 type createEventModalDefaults struct {
-	Title, Desc, Date, Time, Slots string
+	Title, Desc, When, Location, Slots string
 }
 
-func (h *Handler) buildCreateEventModalComponents(d *createEventModalDefaults) []discordgo.MessageComponent {
+func (h *Handler) buildCreateEventModalComponents(i *discordgo.InteractionCreate, d *createEventModalDefaults) []discordgo.MessageComponent {
 	if d == nil {
 		d = &createEventModalDefaults{}
 	}
 	return []discordgo.MessageComponent{
 		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
-			discordgo.TextInput{CustomID: "title", Label: h.translate("ui.label_title", nil), Style: discordgo.TextInputShort, Required: true, Placeholder: h.translate("ui.placeholder_title", nil), Value: d.Title},
+			discordgo.TextInput{CustomID: "title", Label: h.translateFor(i, "ui.label_title", nil), Style: discordgo.TextInputShort, Required: true, Placeholder: h.translateFor(i, "ui.placeholder_title", nil), Value: d.Title},
 		}},
 		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
-			discordgo.TextInput{CustomID: "desc", Label: h.translate("ui.label_details", nil), Style: discordgo.TextInputParagraph, Required: true, Placeholder: h.translate("ui.placeholder_desc", nil), Value: d.Desc},
+			discordgo.TextInput{CustomID: "desc", Label: h.translateFor(i, "ui.label_details", nil), Style: discordgo.TextInputParagraph, Required: true, Placeholder: h.translateFor(i, "ui.placeholder_desc", nil), Value: d.Desc},
 		}},
 		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
-			discordgo.TextInput{CustomID: "date", Label: h.translate("ui.label_date", nil), Style: discordgo.TextInputShort, Required: true, Placeholder: h.translate("ui.placeholder_date", nil), Value: d.Date},
+			discordgo.TextInput{CustomID: "when", Label: h.translateFor(i, "ui.label_when", nil), Style: discordgo.TextInputShort, Required: true, Placeholder: h.translateFor(i, "ui.placeholder_when", nil), Value: d.When},
 		}},
 		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
-			discordgo.TextInput{CustomID: "time", Label: h.translate("ui.label_time", nil), Style: discordgo.TextInputShort, Required: true, Placeholder: h.translate("ui.placeholder_time", nil), Value: d.Time},
+			discordgo.TextInput{CustomID: "location", Label: h.translateFor(i, "ui.label_location", nil), Style: discordgo.TextInputShort, Required: false, MaxLength: maxLocationLength, Placeholder: h.translateFor(i, "ui.placeholder_location", nil), Value: d.Location},
 		}},
 		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
-			discordgo.TextInput{CustomID: "slots", Label: h.translate("ui.label_slots", nil), Style: discordgo.TextInputShort, Required: false, Placeholder: h.translate("ui.placeholder_slots", nil), Value: d.Slots},
+			discordgo.TextInput{CustomID: "slots", Label: h.translateFor(i, "ui.label_slots", nil), Style: discordgo.TextInputShort, Required: false, Placeholder: h.translateFor(i, "ui.placeholder_slots", nil), Value: d.Slots},
 		}},
 	}
 }
 
+// requireForum answers the interaction and returns false when the guild has no forum configured yet.
+func (h *Handler) requireForum(s *discordgo.Session, i *discordgo.InteractionCreate) bool {
+	if h.guildSettings(context.Background(), i.GuildID).ForumChannelID == "" {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.forum_not_configured", nil))
+		return false
+	}
+	return true
+}
+
+// HandleCommand opens the creation modal; the optional recurrence is carried by the modal CustomID.
 func (h *Handler) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if !h.requireForum(s, i) {
+		return
+	}
+	customID := "create_event_modal"
+	timezone := ""
+	for _, opt := range i.ApplicationCommandData().Options {
+		switch {
+		case opt.Name == "repetition" && opt.StringValue() != "":
+			customID += "_" + opt.StringValue()
+		case opt.Name == "fuseau":
+			timezone = strings.TrimSpace(opt.StringValue())
+		}
+	}
+	// Fuseau propre à cette sortie ; sinon celui du serveur est utilisé à la soumission.
+	if timezone != "" {
+		if !tz.Valid(timezone) {
+			respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.invalid_timezone", nil))
+			return
+		}
+		customID += "@" + timezone
+	}
 	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseModal,
 		Data: &discordgo.InteractionResponseData{
-			CustomID:   "create_event_modal",
-			Title:      h.translate("ui.modal_create_event_title", nil),
-			Components: h.buildCreateEventModalComponents(nil),
+			CustomID:   customID,
+			Title:      h.translateFor(i, "ui.modal_create_event_title", nil),
+			Components: h.buildCreateEventModalComponents(i, nil),
 		},
 	})
 }
 
 func (h *Handler) HandleTemplateCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if !h.requireForum(s, i) {
+		return
+	}
 	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseModal,
 		Data: &discordgo.InteractionResponseData{
 			CustomID: "create_event_modal",
-			Title:    h.translate("ui.modal_create_event_template_title", nil),
-			Components: h.buildCreateEventModalComponents(&createEventModalDefaults{
-				Title: h.translate("ui.template_title_default", nil),
-				Desc:  h.translate("ui.template_desc_default", nil),
-				Date:  h.translate("ui.template_date_default", nil),
-				Time:  h.translate("ui.template_time_default", nil),
-				Slots: h.translate("ui.template_slots_default", nil),
+			Title:    h.translateFor(i, "ui.modal_create_event_template_title", nil),
+			Components: h.buildCreateEventModalComponents(i, &createEventModalDefaults{
+				Title:    h.translateFor(i, "ui.template_title_default", nil),
+				Desc:     h.translateFor(i, "ui.template_desc_default", nil),
+				When:     h.translateFor(i, "ui.template_when_default", nil),
+				Location: h.translateFor(i, "ui.template_location_default", nil),
+				Slots:    h.translateFor(i, "ui.template_slots_default", nil),
 			}),
 		},
 	})
@@ -0,0 +1,236 @@
+package discord
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/domainevent"
+	"servbot/internal/domain/entities"
+	pkgdiscord "servbot/pkg/discord"
+)
+
+// HandleDomainEvent applies the Discord side effects of a change saved by the application:
+// embed refresh, DMs and private channel permissions. Interaction replies stay in the handlers.
+func (h *Handler) HandleDomainEvent(s *discordgo.Session, ctx context.Context, e domainevent.Event) {
+	switch e := e.(type) {
+	case domainevent.EventEdited:
+		h.onEventEdited(s, ctx, &e.Event)
+	case domainevent.EventFinalized:
+		h.onEventFinalized(s, ctx, &e.Event)
+	case domainevent.EventCancelled:
+		h.onEventCancelled(s, ctx, &e.Event)
+	case domainevent.ParticipantJoined:
+		h.onParticipantJoined(s, ctx, &e.Event, &e.Participant)
+	case domainevent.ParticipantLeft:
+		h.onParticipantLeft(s, ctx, &e.Event, &e.Participant)
+	case domainevent.ParticipantRemoved:
+		h.onParticipantRemoved(s, ctx, &e.Event, &e.Participant, e.Refused)
+	case domainevent.ParticipantPromoted:
+		h.onParticipantPromoted(s, ctx, &e.Event, &e.Participant, e.ByOrganizer, e.AcceptedOffer)
+	case domainevent.ParticipantOffered:
+		h.onParticipantOffered(s, ctx, &e.Event, &e.Participant)
+	case domainevent.ParticipantDeclinedOffer:
+		h.onParticipantDeclinedOffer(s, ctx, &e.Event, &e.Participant, e.Expired)
+	case domainevent.ParticipantGuestsChanged:
+		h.onParticipantGuestsChanged(s, ctx, &e.Event)
+	case domainevent.CoHostAdded:
+		h.onCoHostAdded(s, ctx, &e.Event, e.UserID)
+	case domainevent.CoHostRemoved:
+		h.onCoHostRemoved(s, ctx, &e.Event, e.UserID)
+	case domainevent.SlotFreed:
+		h.onSlotFreed(s, ctx, &e.Event)
+	}
+}
+
+func (h *Handler) onEventEdited(s *discordgo.Session, ctx context.Context, event *entities.Event) {
+	h.syncDiscordScheduledEvent(s, event)
+	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
+}
+
+// onEventFinalized confirms their place to the participants, opens the private channel to them
+// and adds the event to the guild calendar.
+func (h *Handler) onEventFinalized(s *discordgo.Session, ctx context.Context, event *entities.Event) {
+	for _, p := range event.Participants {
+		if p.Status != domain.StatusConfirmed || p.UserID == event.CreatorID {
+			continue
+		}
+		locale := h.userLocale(ctx, p.UserID)
+		var dmContent string
+		if link := messageLink(s, event.GuildID, event.ChannelID, event.MessageID); link != "" {
+			dmContent = h.translateIn(locale, "dm.finalize_confirmed_with_link", map[string]any{
+				"EventTitle": event.Title,
+				"Link":       link,
+			})
+		} else {
+			dmContent = h.translateIn(locale, "dm.finalize_confirmed_no_link", map[string]any{
+				"EventTitle": event.Title,
+			})
+		}
+		if !event.ScheduledAt.IsZero() {
+			dmContent += "\n" + h.translateIn(locale, "ui.dm_date_line", map[string]any{"Date": pkgdiscord.FormatTimestamp(event.ScheduledAt)})
+		}
+		icsFile := eventICSFile(event)
+		if icsFile != nil {
+			dmContent += h.translateIn(locale, "dm.finalize_calendar_hint", nil)
+		}
+		dmContent += h.translateIn(locale, "dm.finalize_confirmed_footer", nil)
+		sendDMWithFile(s, p.UserID, dmContent, icsFile)
+		grantPrivateChannelAccess(s, event.PrivateChannelID, p.UserID)
+	}
+
+	if !event.ScheduledAt.IsZero() {
+		h.createDiscordScheduledEvent(s, event)
+	}
+
+	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
+
+	if event.SeriesID != 0 {
+		h.processSeries(s, ctx, event.SeriesID, time.Now())
+	}
+}
+
+// onEventCancelled notifies the participants, then closes the post and the private channel.
+func (h *Handler) onEventCancelled(s *discordgo.Session, ctx context.Context, event *entities.Event) {
+	dmData := map[string]any{"EventTitle": event.Title, "Reason": event.CancelReason}
+	if !event.ScheduledAt.IsZero() {
+		dmData["Date"] = pkgdiscord.FormatTimestamp(event.ScheduledAt)
+	}
+	for _, p := range event.Participants {
+		if p.UserID == event.CreatorID {
+			continue
+		}
+		sendDM(s, p.UserID, h.translateIn(h.userLocale(ctx, p.UserID), "dm.event_cancelled", dmData))
+	}
+
+	// The embed must be edited before the thread is archived.
+	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
+	h.deleteDiscordScheduledEvent(s, event)
+	closeThread(s, event.QuestionsThreadID)
+	closeThread(s, event.ChannelID)
+	lockPrivateChannel(s, event.PrivateChannelID, s.State.User.ID)
+}
+
+// onParticipantJoined opens the private channel of a finalized event to a confirmed joiner and
+// asks the organizer to validate as the timing requires.
+func (h *Handler) onParticipantJoined(s *discordgo.Session, ctx context.Context, event *entities.Event, participant *entities.Participant) {
+	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
+
+	confirmed := participant.Status == domain.StatusConfirmed
+	if event.IsFinalized() && confirmed {
+		grantPrivateChannelAccess(s, event.PrivateChannelID, participant.UserID)
+	}
+
+	now := time.Now()
+	if isCasA(event.ScheduledAt, now) {
+		h.sendValidationDMIfFull(s, ctx, event)
+	} else if isCasB(event.ScheduledAt, now) && confirmed {
+		if err := h.sendOrganizerAcceptRefuseDM(s, event, participant); err != nil {
+			log.Printf("❌ Envoi MP Accepter/Refuser organisateur (Cas B): %v", err)
+		}
+	}
+}
+
+// sendValidationDMIfFull asks the organizer to validate the list once every seat is taken (Cas A).
+func (h *Handler) sendValidationDMIfFull(s *discordgo.Session, ctx context.Context, event *entities.Event) {
+	if event.MaxSlots == 0 || !event.OrganizerValidationDMSentAt.IsZero() {
+		return
+	}
+	eventFull, err := h.eventUseCase.GetEventByID(ctx, event.ID)
+	if err != nil || eventFull.TakenSeats() < event.MaxSlots {
+		return
+	}
+	if err := h.sendOrganizerValidationDM(s, eventToEventWithParticipants(eventFull)); err != nil {
+		log.Printf("❌ Envoi MP validation organisateur (Cas A complet): %v", err)
+	} else {
+		_ = h.eventUseCase.MarkOrganizerValidationDMSent(ctx, event.ID)
+	}
+}
+
+func (h *Handler) onParticipantLeft(s *discordgo.Session, ctx context.Context, event *entities.Event, participant *entities.Participant) {
+	if !h.eventUseCase.CanManageEvent(event, participant.UserID) {
+		revokePrivateChannelAccess(s, event.PrivateChannelID, participant.UserID)
+	}
+	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
+	sendDM(s, participant.UserID, h.translateIn(h.userLocale(ctx, participant.UserID), "dm.leave.confirmed", nil))
+}
+
+func (h *Handler) onParticipantRemoved(s *discordgo.Session, ctx context.Context, event *entities.Event, participant *entities.Participant, refused bool) {
+	if !h.eventUseCase.CanManageEvent(event, participant.UserID) {
+		revokePrivateChannelAccess(s, event.PrivateChannelID, participant.UserID)
+	}
+	_ = s.MessageReactionRemove(event.ChannelID, event.MessageID, reactionJoinEmoji, participant.UserID)
+	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
+	key := "dm.removed_by_organizer"
+	if refused {
+		key = "dm.organizer_refused"
+	}
+	sendDM(s, participant.UserID, h.translateIn(h.userLocale(ctx, participant.UserID), key, map[string]any{"EventTitle": event.Title}))
+}
+
+// onParticipantPromoted notifies the promoted participant, unless they accepted a slot offer
+// themselves and already got the answer in the offer DM.
+func (h *Handler) onParticipantPromoted(s *discordgo.Session, ctx context.Context, event *entities.Event, participant *entities.Participant, byOrganizer, acceptedOffer bool) {
+	if !acceptedOffer {
+		key := "dm.waitlist.promoted_auto"
+		if byOrganizer {
+			key = "dm.waitlist.promoted_by_organizer"
+		}
+		sendDM(s, participant.UserID, h.translateIn(h.userLocale(ctx, participant.UserID), key, map[string]any{"EventTitle": event.Title}))
+	}
+	if shouldGrantPrivateChannelOnPromote(event, time.Now()) {
+		grantPrivateChannelAccess(s, event.PrivateChannelID, participant.UserID)
+	}
+	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
+}
+
+func (h *Handler) onParticipantOffered(s *discordgo.Session, ctx context.Context, event *entities.Event, participant *entities.Participant) {
+	if err := h.sendSlotOfferDM(s, ctx, event, participant); err != nil {
+		log.Printf("❌ Envoi MP place proposée (event %d): %v", event.ID, err)
+	}
+	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
+}
+
+// onParticipantDeclinedOffer tidies the post after an offer was declined or expired; the next
+// offer follows through SlotFreed.
+func (h *Handler) onParticipantDeclinedOffer(s *discordgo.Session, ctx context.Context, event *entities.Event, participant *entities.Participant, expired bool) {
+	_ = s.MessageReactionRemove(event.ChannelID, event.MessageID, reactionJoinEmoji, participant.UserID)
+	if expired {
+		sendDM(s, participant.UserID, h.translateIn(h.userLocale(ctx, participant.UserID), "dm.waitlist.offer_expired", map[string]any{"EventTitle": event.Title}))
+	}
+	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
+}
+
+// onParticipantGuestsChanged refreshes the places; more guests may fill the event.
+func (h *Handler) onParticipantGuestsChanged(s *discordgo.Session, ctx context.Context, event *entities.Event) {
+	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
+	if isCasA(event.ScheduledAt, time.Now()) {
+		h.sendValidationDMIfFull(s, ctx, event)
+	}
+}
+
+// onSlotFreed hands a freed place over to the waitlist.
+// Auto: offer the free seats to the waitlist. Manual + Cas A: no promo. Manual + Cas B: DM orga Accept/Ignore.
+func (h *Handler) onSlotFreed(s *discordgo.Session, ctx context.Context, event *entities.Event) {
+	if event.IsCancelled() {
+		return
+	}
+	if event.WaitlistAuto {
+		// The offers are published as ParticipantOffered.
+		_, _ = h.participantUseCase.OfferSlotToNextWaitlist(ctx, event.ID, time.Now())
+		return
+	}
+	if !isCasB(event.ScheduledAt, time.Now()) {
+		return
+	}
+	waitlist, err := h.eventUseCase.GetWaitlistParticipants(ctx, event.ID)
+	if err != nil || len(waitlist) == 0 {
+		return
+	}
+	if err := h.sendWaitlistSlotFreedDM(s, event, &waitlist[0]); err != nil {
+		log.Printf("❌ Envoi MP place libérée organisateur (Cas B): %v", err)
+	}
+}
@@ -2,11 +2,13 @@ package discord
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
 
+	"servbot/internal/domain"
 	"servbot/internal/domain/entities"
 	pkgdiscord "servbot/pkg/discord"
 
@@ -63,33 +65,34 @@ func (h *Handler) HandleToggleWaitlistMode(s *discordgo.Session, i *discordgo.In
 
 	event, err := h.eventUseCase.GetEventByMessageID(ctx, msgID)
 	if err != nil || event == nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.event_not_found", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_not_found", nil))
 		return
 	}
-	if event.CreatorID != userID {
-		respondEphemeral(s, i.Interaction, h.translate("errors.toggle_waitlist_only_organizer", nil))
+	if !h.eventUseCase.CanManageEvent(event, userID) {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.toggle_waitlist_only_organizer", nil))
 		return
 	}
 	if event.IsEditLocked() {
-		respondEphemeral(s, i.Interaction, h.translate("errors.toggle_waitlist_locked", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.toggle_waitlist_locked", nil))
 		return
 	}
 
 	event.WaitlistAuto = !event.WaitlistAuto
-	if err := h.eventUseCase.UpdateEvent(ctx, event); err != nil {
+	if err := h.eventUseCase.UpdateEvent(ctx, event, userID); err != nil {
+		if errors.Is(err, domain.ErrNotOrganizer) {
+			respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.toggle_waitlist_only_organizer", nil))
+			return
+		}
 		log.Printf("❌ Erreur lors du changement de mode waitlist: %v", err)
-		respondEphemeral(s, i.Interaction, h.translate("errors.toggle_waitlist_update_failed", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.toggle_waitlist_update_failed", nil))
 		return
 	}
 
-	// Refresh embed + components to update the button label.
-	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
-
 	modeLabel := "auto"
 	if !event.WaitlistAuto {
 		modeLabel = "manuel"
 	}
-	respondEphemeral(s, i.Interaction, h.translate("success.toggle_waitlist", map[string]any{
+	respondEphemeral(s, i.Interaction, h.translateFor(i, "success.toggle_waitlist", map[string]any{
 		"Mode": modeLabel,
 	}))
 }
@@ -100,10 +103,10 @@ func (h *Handler) updateEmbed(ctx context.Context, s *discordgo.Session, channel
 		log.Printf("❌ Erreur lors de la récupération de l'événement: %v", err)
 		return
 	}
-	confirmedParticipants, _ := h.eventUseCase.GetConfirmedParticipants(ctx, event.ID)
 	waitlistParticipants, _ := h.eventUseCase.GetWaitlistParticipants(ctx, event.ID)
-	confirmedCount := len(confirmedParticipants)
 	waitlistCount := len(waitlistParticipants)
+	// Places are counted in seats: a pending slot offer holds its seats, guests take one each.
+	takenSeats := event.TakenSeats()
 
 	origMsg, err := s.ChannelMessage(channelID, messageID)
 	if err != nil || origMsg == nil || len(origMsg.Embeds) == 0 {
@@ -112,9 +115,10 @@ func (h *Handler) updateEmbed(ctx context.Context, s *discordgo.Session, channel
 	}
 
 	newEmbed := *origMsg.Embeds[0]
-	pkgdiscord.UpdateEventEmbed(&newEmbed, event, confirmedCount, waitlistCount)
+	locale := h.eventLocale(ctx, s, event)
+	pkgdiscord.UpdateEventEmbed(h.translator, locale, &newEmbed, event, takenSeats, waitlistCount)
 
-	components := h.buildComponents(event, waitlistCount, confirmedCount)
+	components := h.buildComponents(locale, event, waitlistCount, takenSeats)
 
 	embeds := []*discordgo.MessageEmbed{&newEmbed}
 	if _, err := s.ChannelMessageEditComplex(&discordgo.MessageEdit{
@@ -129,20 +133,23 @@ func (h *Handler) updateEmbed(ctx context.Context, s *discordgo.Session, channel
 
 const buttonsPerRow = 2
 
-func (h *Handler) buildComponents(event *entities.Event, waitlistCount, confirmedCount int) []discordgo.MessageComponent {
+func (h *Handler) buildComponents(locale string, event *entities.Event, waitlistCount, takenSeats int) []discordgo.MessageComponent {
+	if event.IsCancelled() || event.HasEnded() {
+		return []discordgo.MessageComponent{}
+	}
 	var buttons []discordgo.MessageComponent
 	if !event.IsEditLocked() {
-		buttons = append(buttons, discordgo.Button{Label: h.translate("ui.btn_edit_event", nil), Style: discordgo.SecondaryButton, CustomID: fmt.Sprintf("btn_edit_event_%s", event.MessageID)})
+		buttons = append(buttons, discordgo.Button{Label: h.translateIn(locale, "ui.btn_edit_event", nil), Style: discordgo.SecondaryButton, CustomID: fmt.Sprintf("btn_edit_event_%s", event.MessageID)})
 	}
 	buttons = append(buttons, discordgo.Button{
-		Label:    h.translate("ui.btn_ask_question", nil),
+		Label:    h.translateIn(locale, "ui.btn_ask_question", nil),
 		Style:    discordgo.SecondaryButton,
 		CustomID: fmt.Sprintf("btn_ask_question_%s", event.MessageID),
 	})
-	modeLabel := h.translate("ui.btn_waitlist_auto", nil)
+	modeLabel := h.translateIn(locale, "ui.btn_waitlist_auto", nil)
 	modeStyle := discordgo.SuccessButton
 	if !event.WaitlistAuto {
-		modeLabel = h.translate("ui.btn_waitlist_manual", nil)
+		modeLabel = h.translateIn(locale, "ui.btn_waitlist_manual", nil)
 		modeStyle = discordgo.PrimaryButton
 	}
 	buttons = append(buttons, discordgo.Button{
@@ -151,10 +158,19 @@ func (h *Handler) buildComponents(event *entities.Event, waitlistCount, confirme
 		CustomID: fmt.Sprintf("btn_toggle_waitlist_%s", event.MessageID),
 	})
 	if waitlistCount > 0 {
-		buttons = append(buttons, discordgo.Button{Label: h.translate("ui.btn_manage_waitlist", nil), Style: discordgo.SecondaryButton, CustomID: fmt.Sprintf("btn_manage_waitlist_%s", event.MessageID)})
+		buttons = append(buttons, discordgo.Button{Label: h.translateIn(locale, "ui.btn_manage_waitlist", nil), Style: discordgo.SecondaryButton, CustomID: fmt.Sprintf("btn_manage_waitlist_%s", event.MessageID)})
+	}
+	if event.MaxGuests > 0 && !event.HasStarted() {
+		buttons = append(buttons, discordgo.Button{Label: h.translateIn(locale, "ui.btn_guests", nil), Style: discordgo.SecondaryButton, CustomID: fmt.Sprintf("btn_guests_%s", event.MessageID)})
+	}
+	if takenSeats > 0 {
+		buttons = append(buttons, discordgo.Button{Label: h.translateIn(locale, "ui.btn_remove_participant", nil), Style: discordgo.DangerButton, CustomID: fmt.Sprintf("btn_remove_participant_%s", event.MessageID)})
+	}
+	if event.SeriesID != 0 {
+		buttons = append(buttons, discordgo.Button{Label: h.translateIn(locale, "ui.btn_manage_series", nil), Style: discordgo.SecondaryButton, CustomID: fmt.Sprintf("btn_manage_series_%s", event.MessageID)})
 	}
-	if confirmedCount > 0 {
-		buttons = append(buttons, discordgo.Button{Label: h.translate("ui.btn_remove_participant", nil), Style: discordgo.DangerButton, CustomID: fmt.Sprintf("btn_remove_participant_%s", event.MessageID)})
+	if !event.HasStarted() {
+		buttons = append(buttons, discordgo.Button{Label: h.translateIn(locale, "ui.btn_cancel_event", nil), Style: discordgo.DangerButton, CustomID: fmt.Sprintf("btn_cancel_event_%s", event.MessageID)})
 	}
 	var components []discordgo.MessageComponent
 	for i := 0; i < len(buttons); i += buttonsPerRow {
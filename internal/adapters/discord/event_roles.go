@@ -0,0 +1,157 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+)
+
+const maxEventRoles = 10
+
+// roleNames lists the roles as "@name", falling back to a mention for roles missing from the state.
+func roleNames(s *discordgo.Session, guildID string, roleIDs []string) string {
+	names := make([]string, 0, len(roleIDs))
+	for _, id := range roleIDs {
+		if role, err := s.State.Role(guildID, id); err == nil && role != nil {
+			names = append(names, "@"+role.Name)
+		} else {
+			names = append(names, "<@&"+id+">")
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// HandleRolesCommand is triggered by the /acces slash command from the private channel: the
+// organizer picks the roles allowed to register and those with priority on the waitlist.
+func (h *Handler) HandleRolesCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	event, err := h.eventUseCase.GetEventByPrivateChannelID(context.Background(), i.ChannelID)
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.remove_command_wrong_channel", nil))
+		return
+	}
+	switch {
+	case !h.eventUseCase.CanManageEvent(event, interactionUserID(i)):
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.only_organizer_can_edit", nil))
+	case event.IsCancelled():
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_cancelled", nil))
+	case event.HasStarted():
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.roles_event_started", nil))
+	default:
+		data := h.eventRolesData(s, i, event, "")
+		data.Flags = discordgo.MessageFlagsEphemeral
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: data,
+		})
+	}
+}
+
+// HandleEventRolesSelect saves the roles picked in one of the menus of /acces.
+func (h *Handler) HandleEventRolesSelect(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	data := i.MessageComponentData()
+	eventID, priority := parseParticipantID(data.CustomID, "select_event_roles_priority_")
+	if !priority {
+		var ok bool
+		if eventID, ok = parseParticipantID(data.CustomID, "select_event_roles_allowed_"); !ok {
+			return
+		}
+	}
+	event, err := h.eventUseCase.GetEventByID(ctx, eventID)
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_not_found", nil))
+		return
+	}
+	allowed, prioritized := event.AllowedRoleIDs, event.PriorityRoleIDs
+	if priority {
+		prioritized = data.Values
+	} else {
+		allowed = data.Values
+	}
+
+	event, err = h.eventUseCase.SetEventRoles(ctx, eventID, interactionUserID(i), allowed, prioritized)
+	if err != nil {
+		var key string
+		switch {
+		case errors.Is(err, domain.ErrNotOrganizer):
+			key = "errors.only_organizer_can_edit"
+		case errors.Is(err, domain.ErrEventStarted):
+			key = "errors.roles_event_started"
+		case errors.Is(err, domain.ErrEventNotFound), errors.Is(err, domain.ErrEventCancelled):
+			key = "errors." + domain.Code(err)
+		default:
+			log.Printf("❌ Mise à jour des rôles (event %d): %v", eventID, err)
+			key = "errors.generic"
+		}
+		respondEphemeral(s, i.Interaction, h.translateFor(i, key, nil))
+		return
+	}
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: h.eventRolesData(s, i, event, h.translateFor(i, "success.event_roles_updated", nil)),
+	})
+}
+
+// eventRolesData renders the current roles of event under notice, with the two role menus.
+func (h *Handler) eventRolesData(s *discordgo.Session, i *discordgo.InteractionCreate, event *entities.Event, notice string) *discordgo.InteractionResponseData {
+	var b strings.Builder
+	if notice != "" {
+		b.WriteString(notice + "\n\n")
+	}
+	b.WriteString(h.translateFor(i, "ui.event_roles_intro", map[string]any{"EventTitle": event.Title}))
+	allowed := h.translateFor(i, "ui.event_roles_everyone", nil)
+	if len(event.AllowedRoleIDs) > 0 {
+		allowed = roleNames(s, event.GuildID, event.AllowedRoleIDs)
+	}
+	prioritized := h.translateFor(i, "ui.event_roles_none", nil)
+	if len(event.PriorityRoleIDs) > 0 {
+		prioritized = roleNames(s, event.GuildID, event.PriorityRoleIDs)
+	}
+	b.WriteString("\n" + h.translateFor(i, "ui.event_roles_summary", map[string]any{"Allowed": allowed, "Priority": prioritized}))
+
+	minValues := 0
+	return &discordgo.InteractionResponseData{
+		Content: b.String(),
+		Components: []discordgo.MessageComponent{
+			discordgo.ActionsRow{
+				Components: []discordgo.MessageComponent{
+					discordgo.SelectMenu{
+						MenuType:      discordgo.RoleSelectMenu,
+						CustomID:      fmt.Sprintf("select_event_roles_allowed_%d", event.ID),
+						Placeholder:   h.translateFor(i, "ui.event_roles_allowed_placeholder", nil),
+						MinValues:     &minValues,
+						MaxValues:     maxEventRoles,
+						DefaultValues: roleDefaultValues(event.AllowedRoleIDs),
+					},
+				},
+			},
+			discordgo.ActionsRow{
+				Components: []discordgo.MessageComponent{
+					discordgo.SelectMenu{
+						MenuType:      discordgo.RoleSelectMenu,
+						CustomID:      fmt.Sprintf("select_event_roles_priority_%d", event.ID),
+						Placeholder:   h.translateFor(i, "ui.event_roles_priority_placeholder", nil),
+						MinValues:     &minValues,
+						MaxValues:     maxEventRoles,
+						DefaultValues: roleDefaultValues(event.PriorityRoleIDs),
+					},
+				},
+			},
+		},
+	}
+}
+
+func roleDefaultValues(roleIDs []string) []discordgo.SelectMenuDefaultValue {
+	values := make([]discordgo.SelectMenuDefaultValue, 0, len(roleIDs))
+	for _, id := range roleIDs {
+		values = append(values, discordgo.SelectMenuDefaultValue{ID: id, Type: discordgo.SelectMenuDefaultValueRole})
+	}
+	return values
+}
@@ -0,0 +1,61 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"servbot/internal/domain/entities"
+	pkgdiscord "servbot/pkg/discord"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// updateFAQ rebuilds the FAQ message of the forum post from the published questions.
+// The message is posted on the first published answer, then edited in place.
+func (h *Handler) updateFAQ(ctx context.Context, s *discordgo.Session, event *entities.Event) {
+	if event.ChannelID == "" {
+		return
+	}
+	questions, err := h.questionUseCase.GetPublishedQuestions(ctx, event.ID)
+	if err != nil {
+		log.Printf("❌ Récupération de la FAQ (event %d): %v", event.ID, err)
+		return
+	}
+	if len(questions) == 0 {
+		return
+	}
+	embed := pkgdiscord.BuildFAQEmbed(h.translator, h.eventLocale(ctx, s, event), questions)
+
+	if event.FAQMessageID != "" {
+		embeds := []*discordgo.MessageEmbed{embed}
+		_, err := s.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			ID:      event.FAQMessageID,
+			Channel: event.ChannelID,
+			Embeds:  &embeds,
+		})
+		if err == nil {
+			return
+		}
+		if !isNotFound(err) {
+			log.Printf("❌ Mise à jour de la FAQ (event %d): %v", event.ID, err)
+			return
+		}
+		// The FAQ message was deleted: post a new one.
+	}
+
+	msg, err := s.ChannelMessageSendEmbed(event.ChannelID, embed)
+	if err != nil {
+		log.Printf("❌ Publication de la FAQ (event %d): %v", event.ID, err)
+		return
+	}
+	if err := h.questionUseCase.AttachFAQMessage(ctx, event.ID, msg.ID); err != nil {
+		log.Printf("❌ Sauvegarde du message FAQ (event %d): %v", event.ID, err)
+	}
+}
+
+func isNotFound(err error) bool {
+	var restErr *discordgo.RESTError
+	return errors.As(err, &restErr) && restErr.Response != nil && restErr.Response.StatusCode == http.StatusNotFound
+}
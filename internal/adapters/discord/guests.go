@@ -0,0 +1,109 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/bwmarrin/discordgo"
+
+	"servbot/internal/domain"
+)
+
+// HandleGuests is triggered by the embed "Invités" button: it lets a registered member choose
+// how many guests they bring, up to the cap set by the organizer.
+func (h *Handler) HandleGuests(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	event, err := h.eventUseCase.GetEventByMessageID(ctx, i.Message.ID)
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_not_found", nil))
+		return
+	}
+	participant, err := h.participantUseCase.GetParticipantByEventIDAndUserID(ctx, event.ID, interactionUserID(i))
+	if err != nil || participant == nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.guests_not_registered", nil))
+		return
+	}
+	if event.MaxGuests == 0 {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.too_many_guests", nil))
+		return
+	}
+
+	options := make([]discordgo.SelectMenuOption, 0, event.MaxGuests+1)
+	for n := 0; n <= event.MaxGuests; n++ {
+		label := h.translateFor(i, "ui.guests_option_none", nil)
+		if n > 0 {
+			label = h.translateFor(i, "ui.guests_option", map[string]any{"Count": n})
+		}
+		options = append(options, discordgo.SelectMenuOption{
+			Label:   label,
+			Value:   strconv.Itoa(n),
+			Default: n == participant.Guests,
+		})
+	}
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: h.translateFor(i, "ui.guests_select_intro", map[string]any{"EventTitle": event.Title, "Max": event.MaxGuests}),
+			Flags:   discordgo.MessageFlagsEphemeral,
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.SelectMenu{
+							CustomID:    fmt.Sprintf("select_guests_%d", event.ID),
+							Placeholder: h.translateFor(i, "ui.guests_placeholder", nil),
+							Options:     options,
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+// HandleGuestsSelect saves the number of guests chosen in the menu of HandleGuests.
+func (h *Handler) HandleGuestsSelect(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	data := i.MessageComponentData()
+	eventID, ok := parseParticipantID(data.CustomID, "select_guests_")
+	if !ok || len(data.Values) == 0 {
+		return
+	}
+	guests, err := strconv.Atoi(data.Values[0])
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.invalid_selection", nil))
+		return
+	}
+
+	participant, err := h.participantUseCase.SetGuests(context.Background(), eventID, interactionUserID(i), guests)
+	if err != nil {
+		var key string
+		switch {
+		case errors.Is(err, domain.ErrParticipantNotFound):
+			key = "errors.guests_not_registered"
+		case errors.Is(err, domain.ErrEventFull):
+			key = "errors.guests_event_full"
+		case errors.Is(err, domain.ErrTooManyGuests), errors.Is(err, domain.ErrEventNotFound),
+			errors.Is(err, domain.ErrEventCancelled), errors.Is(err, domain.ErrEventStarted):
+			key = "errors." + domain.Code(err)
+		default:
+			log.Printf("❌ Mise à jour des invités (event %d): %v", eventID, err)
+			key = "errors.generic"
+		}
+		respondEphemeral(s, i.Interaction, h.translateFor(i, key, nil))
+		return
+	}
+
+	key := "success.guests_updated"
+	if participant.Guests == 0 {
+		key = "success.guests_removed"
+	}
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    h.translateFor(i, key, map[string]any{"Count": participant.Guests}),
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+}
@@ -0,0 +1,117 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// guildSettings returns the settings of guildID, falling back to the defaults when they cannot be loaded.
+func (h *Handler) guildSettings(ctx context.Context, guildID string) *entities.GuildSettings {
+	settings, err := h.guildSettingsUseCase.GetGuildSettings(ctx, guildID)
+	if err != nil {
+		log.Printf("❌ Paramètres du serveur %s: %v", guildID, err)
+		return entities.DefaultGuildSettings(guildID)
+	}
+	return settings
+}
+
+// eventLocale returns the language of the guild of event, used for its public messages.
+func (h *Handler) eventLocale(ctx context.Context, s *discordgo.Session, event *entities.Event) string {
+	return h.guildSettings(ctx, eventGuildID(s, event.GuildID, event.ChannelID)).Locale
+}
+
+// channelGuildID resolves the guild owning channelID, for flows that run outside a guild interaction (DMs, scheduler).
+func channelGuildID(s *discordgo.Session, channelID string) string {
+	if channelID == "" {
+		return ""
+	}
+	if ch, err := s.State.Channel(channelID); err == nil && ch != nil {
+		return ch.GuildID
+	}
+	ch, err := s.Channel(channelID)
+	if err != nil || ch == nil {
+		return ""
+	}
+	return ch.GuildID
+}
+
+// eventGuildID returns the guild stored on an event, or resolves it from its channel for events
+// created before guild_id was stored.
+func eventGuildID(s *discordgo.Session, guildID, channelID string) string {
+	if guildID != "" {
+		return guildID
+	}
+	return channelGuildID(s, channelID)
+}
+
+func (h *Handler) formatGuildSettings(i *discordgo.InteractionCreate, settings *entities.GuildSettings) string {
+	unset := h.translateFor(i, "ui.config_unset", nil)
+	forum, category := unset, h.translateFor(i, "ui.config_category_forum", nil)
+	if settings.ForumChannelID != "" {
+		forum = "<#" + settings.ForumChannelID + ">"
+	}
+	if settings.PrivateCategoryID != "" {
+		category = "<#" + settings.PrivateCategoryID + ">"
+	}
+	waitlist := h.translateFor(i, "ui.config_waitlist_auto", nil)
+	if !settings.WaitlistAuto {
+		waitlist = h.translateFor(i, "ui.config_waitlist_manual", nil)
+	}
+	return h.translateFor(i, "ui.config_summary", map[string]any{
+		"Forum":      forum,
+		"Category":   category,
+		"Locale":     settings.Locale,
+		"Timezone":   settings.Timezone,
+		"Waitlist":   waitlist,
+		"OfferDelay": settings.OfferDelayHours,
+	})
+}
+
+// HandleConfigCommand shows the guild settings, or updates the ones given as options (/config, Manage Server only).
+func (h *Handler) HandleConfigCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" || i.Member == nil || i.Member.Permissions&discordgo.PermissionManageGuild == 0 {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.config_admin_only", nil))
+		return
+	}
+	ctx := context.Background()
+	settings := h.guildSettings(ctx, i.GuildID)
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		respondEphemeral(s, i.Interaction, h.formatGuildSettings(i, settings))
+		return
+	}
+	for _, opt := range options {
+		switch opt.Name {
+		case "forum":
+			settings.ForumChannelID = opt.ChannelValue(nil).ID
+		case "categorie":
+			settings.PrivateCategoryID = opt.ChannelValue(nil).ID
+		case "langue":
+			settings.Locale = opt.StringValue()
+		case "fuseau":
+			settings.Timezone = opt.StringValue()
+		case "attente":
+			settings.WaitlistAuto = opt.StringValue() == "auto"
+		case "delai_offre":
+			settings.OfferDelayHours = int(opt.IntValue())
+		}
+	}
+	if err := h.guildSettingsUseCase.UpdateGuildSettings(ctx, settings); err != nil {
+		key := "errors.generic"
+		if errors.Is(err, domain.ErrInvalidLocale) || errors.Is(err, domain.ErrInvalidTimezone) || errors.Is(err, domain.ErrInvalidOfferDelay) {
+			key = "errors." + domain.Code(err)
+		} else {
+			log.Printf("❌ Sauvegarde des paramètres du serveur %s: %v", i.GuildID, err)
+		}
+		respondEphemeral(s, i.Interaction, h.translateFor(i, key, nil))
+		return
+	}
+	respondEphemeral(s, i.Interaction, h.translateFor(i, "success.config_updated", nil)+"\n\n"+h.formatGuildSettings(i, settings))
+}
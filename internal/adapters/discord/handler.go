@@ -6,28 +6,34 @@ import (
 )
 
 type Handler struct {
-	eventUseCase       input.EventUseCase
-	participantUseCase input.ParticipantUseCase
-	translator         output.T
-	forumChannelID     string
-	guildID            string
-	defaultLocale      string
+	eventUseCase         input.EventUseCase
+	participantUseCase   input.ParticipantUseCase
+	questionUseCase      input.QuestionUseCase
+	guildSettingsUseCase input.GuildSettingsUseCase
+	userPrefsUseCase     input.UserPreferencesUseCase
+	calendarUseCase      input.CalendarUseCase
+	translator           output.T
+	publicURL            string // base URL of the HTTP server, "" = no iCal feed
 }
 
 func NewHandler(
 	eventUseCase input.EventUseCase,
 	participantUseCase input.ParticipantUseCase,
+	questionUseCase input.QuestionUseCase,
+	guildSettingsUseCase input.GuildSettingsUseCase,
+	userPrefsUseCase input.UserPreferencesUseCase,
+	calendarUseCase input.CalendarUseCase,
 	translator output.T,
-	forumChannelID string,
-	guildID string,
-	defaultLocale string,
+	publicURL string,
 ) *Handler {
 	return &Handler{
-		eventUseCase:       eventUseCase,
-		participantUseCase: participantUseCase,
-		translator:         translator,
-		forumChannelID:     forumChannelID,
-		guildID:            guildID,
-		defaultLocale:      defaultLocale,
+		eventUseCase:         eventUseCase,
+		participantUseCase:   participantUseCase,
+		questionUseCase:      questionUseCase,
+		guildSettingsUseCase: guildSettingsUseCase,
+		userPrefsUseCase:     userPrefsUseCase,
+		calendarUseCase:      calendarUseCase,
+		translator:           translator,
+		publicURL:            publicURL,
 	}
 }
@@ -3,63 +3,77 @@ package discord
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
 	"servbot/internal/domain"
 	"servbot/internal/domain/entities"
 	pkgdiscord "servbot/pkg/discord"
+	"servbot/pkg/tz"
 
 	"github.com/bwmarrin/discordgo"
 )
 
 // parseSlots convertit la valeur du champ "Nombre de places" en entier
-// (0 ou vide = illimité).
-func parseSlots(slotsStr string) (int, error) {
+// (0 ou vide = illimité), suivie du nombre d'invités autorisés par participant : "12 +2".
+func parseSlots(slotsStr string) (slots, maxGuests int, err error) {
+	slotsStr, guestsStr, hasGuests := strings.Cut(slotsStr, "+")
+	slotsStr = strings.TrimSpace(slotsStr)
+	if hasGuests {
+		maxGuests, err = strconv.Atoi(strings.TrimSpace(guestsStr))
+		if err != nil || maxGuests < 0 || maxGuests > entities.MaxGuestsPerParticipant {
+			return 0, 0, errors.New("invalid guests")
+		}
+	}
 	if slotsStr == "" || slotsStr == "0" {
-		return 0, nil
+		return 0, maxGuests, nil
 	}
-	n, err := strconv.Atoi(slotsStr)
+	slots, err = strconv.Atoi(slotsStr)
 	if err != nil {
-		return 0, err
+		return 0, 0, err
 	}
-	if n < 0 {
-		return 0, errors.New("invalid")
+	if slots < 0 {
+		return 0, 0, errors.New("invalid")
 	}
-	return n, nil
+	return slots, maxGuests, nil
 }
 
-// handleCreateEventModalSubmit gère la soumission du modal de création de sortie.
-func (h *Handler) handleCreateEventModalSubmit(s *discordgo.Session, i *discordgo.InteractionCreate, data discordgo.ModalSubmitInteractionData) {
-	title, desc, dateStr, timeStr, slotsStr := pkgdiscord.ExtractModalData(data)
-
-	if dateStr == "" || timeStr == "" {
-		respondEphemeral(s, i.Interaction, h.translate("errors.datetime_required", nil))
-		return
+// formatSlots is the inverse of parseSlots, used to prefill the edit modal.
+func formatSlots(slots, maxGuests int) string {
+	value := ""
+	if slots > 0 {
+		value = strconv.Itoa(slots)
 	}
-	scheduledAt, err := pkgdiscord.ParseEventDateTime(dateStr, timeStr)
-	if err != nil {
-		// When ParseEventDateTime returns a domain error, resolve it via the domain code.
-		if code := domain.Code(err); code != "" {
-			respondEphemeral(s, i.Interaction, h.translate("errors."+code, nil))
-		} else {
-			respondEphemeral(s, i.Interaction, h.translate("errors.generic", nil))
-		}
-		return
-	}
-	slots, err := parseSlots(slotsStr)
-	if err != nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.invalid_slots", nil))
-		return
+	if maxGuests > 0 {
+		value = strings.TrimSpace(value + " +" + strconv.Itoa(maxGuests))
 	}
-	respondEphemeral(s, i.Interaction, h.translate("info.create_forum_post", nil))
+	return value
+}
 
-	user := i.Member.User
-	displayName := resolveDisplayName(i.Member)
-	embed := pkgdiscord.BuildNewEventEmbed(user.ID, desc, scheduledAt, slots, displayName, user.AvatarURL("256"))
+// createEventModalOptions returns the /sortie options carried by the create modal's CustomID
+// ("create_event_modal", optionally followed by "_<RECURRENCE>" and "@<TIMEZONE>").
+func createEventModalOptions(customID string) (recurrence, timezone string) {
+	rest, timezone, _ := strings.Cut(strings.TrimPrefix(customID, "create_event_modal"), "@")
+	return strings.TrimPrefix(rest, "_"), timezone
+}
+
+var (
+	errForumNotConfigured   = errors.New("forum not configured")
+	errCreateForumPost      = errors.New("create forum post")
+	errCreatePrivateChannel = errors.New("create private channel")
+)
 
+// publishEvent creates the forum post, the organizer's private channel and its Questions thread
+// in the guild of settings, and records their IDs on event. The event itself is not saved.
+func (h *Handler) publishEvent(s *discordgo.Session, settings *entities.GuildSettings, event *entities.Event, embed *discordgo.MessageEmbed) error {
+	if settings.ForumChannelID == "" {
+		return errForumNotConfigured
+	}
+	guildID := settings.GuildID
 	threadData := &discordgo.ThreadStart{
-		Name:                title,
+		Name:                event.Title,
 		AutoArchiveDuration: 1440,
 		Type:                discordgo.ChannelTypeGuildPublicThread,
 	}
@@ -67,13 +81,9 @@ func (h *Handler) handleCreateEventModalSubmit(s *discordgo.Session, i *discordg
 		Embeds: []*discordgo.MessageEmbed{embed},
 	}
 
-	thread, err := s.ForumThreadStartComplex(h.forumChannelID, threadData, messageData)
+	thread, err := s.ForumThreadStartComplex(settings.ForumChannelID, threadData, messageData)
 	if err != nil {
-		log.Println("❌ Erreur création forum post:", err)
-		s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
-			Content: h.translate("errors.create_forum_failed", nil),
-		})
-		return
+		return fmt.Errorf("%w: %v", errCreateForumPost, err)
 	}
 
 	message, err := s.ChannelMessage(thread.ID, thread.ID)
@@ -82,20 +92,20 @@ func (h *Handler) handleCreateEventModalSubmit(s *discordgo.Session, i *discordg
 		msgID = message.ID
 	}
 
-	creatorID := i.Member.User.ID
-	guildID := i.GuildID
 	botID := s.State.User.ID
 
-	parentID := ""
-	if ch, err := s.Channel(h.forumChannelID); err == nil && ch != nil && ch.ParentID != "" {
-		parentID = ch.ParentID
+	parentID := settings.PrivateCategoryID
+	if parentID == "" {
+		if ch, err := s.Channel(settings.ForumChannelID); err == nil && ch != nil {
+			parentID = ch.ParentID
+		}
 	}
 	overwrites := []*discordgo.PermissionOverwrite{
 		{ID: guildID, Type: discordgo.PermissionOverwriteTypeRole, Deny: discordgo.PermissionViewChannel},
 	}
-	privChannelName := sanitizeChannelName(title)
+	privChannelName := sanitizeChannelName(event.Title)
 	if privChannelName == "" {
-		privChannelName = h.translate("ui.default_private_channel_name", nil)
+		privChannelName = h.translateIn(settings.Locale, "ui.default_private_channel_name", nil)
 	}
 	privData := discordgo.GuildChannelCreateData{
 		Name:                 privChannelName,
@@ -107,16 +117,12 @@ func (h *Handler) handleCreateEventModalSubmit(s *discordgo.Session, i *discordg
 	}
 	privCh, err := s.GuildChannelCreateComplex(guildID, privData)
 	if err != nil {
-		log.Printf("❌ Création salon privé sortie: %v", err)
-		s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
-			Content: h.translate("errors.create_private_channel_failed", nil),
-		})
-		return
+		return fmt.Errorf("%w: %v", errCreatePrivateChannel, err)
 	}
-	grantPrivateChannelAccess(s, privCh.ID, creatorID)
+	grantPrivateChannelAccess(s, privCh.ID, event.CreatorID)
 	grantPrivateChannelAccess(s, privCh.ID, botID)
 
-	_, _ = s.ChannelMessageSend(privCh.ID, h.translate("info.private_channel_intro", nil))
+	_, _ = s.ChannelMessageSend(privCh.ID, h.translateIn(settings.Locale, "info.private_channel_intro", nil))
 
 	questionsThreadID := ""
 	questionsThread, threadErr := s.ThreadStart(privCh.ID, "Questions", discordgo.ChannelTypeGuildPrivateThread, 1440)
@@ -124,29 +130,107 @@ func (h *Handler) handleCreateEventModalSubmit(s *discordgo.Session, i *discordg
 		log.Printf("❌ Création thread privé Questions: %v", threadErr)
 	} else {
 		questionsThreadID = questionsThread.ID
-		_ = s.ThreadMemberAdd(questionsThread.ID, creatorID)
+		_ = s.ThreadMemberAdd(questionsThread.ID, event.CreatorID)
 		_ = s.ThreadMemberAdd(questionsThread.ID, botID)
-		_, _ = s.ChannelMessageSend(questionsThread.ID, h.translate("info.questions_thread_intro", nil))
+		_, _ = s.ChannelMessageSend(questionsThread.ID, h.translateIn(settings.Locale, "info.questions_thread_intro", nil))
 	}
 
-	event := &entities.Event{
-		MessageID:         msgID,
-		ChannelID:         thread.ID,
-		CreatorID:         creatorID,
-		Title:             title,
-		Description:       desc,
-		MaxSlots:          slots,
-		ScheduledAt:       scheduledAt,
-		PrivateChannelID:  privCh.ID,
-		QuestionsThreadID: questionsThreadID,
-		WaitlistAuto:      true,
+	event.MessageID = msgID
+	event.ChannelID = thread.ID
+	event.PrivateChannelID = privCh.ID
+	event.QuestionsThreadID = questionsThreadID
+	return nil
+}
+
+// unpublishEvent deletes the forum post and the private channel, with its Questions thread,
+// created by publishEvent for an event that could not be saved.
+func unpublishEvent(s *discordgo.Session, event *entities.Event) {
+	if _, err := s.ChannelDelete(event.ChannelID); err != nil {
+		log.Printf("⚠️ Suppression du post forum %s: %v", event.ChannelID, err)
 	}
+	if _, err := s.ChannelDelete(event.PrivateChannelID); err != nil {
+		log.Printf("⚠️ Suppression du salon privé %s: %v", event.PrivateChannelID, err)
+	}
+}
 
+// handleCreateEventModalSubmit gère la soumission du modal de création de sortie.
+func (h *Handler) handleCreateEventModalSubmit(s *discordgo.Session, i *discordgo.InteractionCreate, data discordgo.ModalSubmitInteractionData) {
+	title, desc, whenStr, location, slotsStr := pkgdiscord.ExtractModalData(data)
+
+	if whenStr == "" {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.datetime_required", nil))
+		return
+	}
 	ctx := context.Background()
-	if err := h.eventUseCase.CreateEvent(ctx, event, displayName); err != nil {
+	settings := h.guildSettings(ctx, i.GuildID)
+	recurrence, timezone := createEventModalOptions(data.CustomID)
+	if timezone == "" {
+		timezone = settings.Timezone
+	}
+	scheduledAt, endsAt, err := pkgdiscord.ParseEventWhen(whenStr, tz.Location(timezone))
+	if err != nil {
+		// When ParseEventWhen returns a domain error, resolve it via the domain code.
+		if code := domain.Code(err); code != "" {
+			respondEphemeral(s, i.Interaction, h.translateFor(i, "errors."+code, nil))
+		} else {
+			respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.generic", nil))
+		}
+		return
+	}
+	slots, maxGuests, err := parseSlots(slotsStr)
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.invalid_slots", nil))
+		return
+	}
+	if recurrence != "" && !domain.IsValidRecurrence(recurrence) {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.invalid_recurrence", nil))
+		return
+	}
+	if settings.ForumChannelID == "" {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.forum_not_configured", nil))
+		return
+	}
+	respondEphemeral(s, i.Interaction, h.translateFor(i, "info.create_forum_post", nil))
+
+	user := i.Member.User
+	displayName := resolveDisplayName(i.Member)
+	embed := pkgdiscord.BuildNewEventEmbed(h.translator, settings.Locale, user.ID, desc, location, scheduledAt, endsAt, slots, maxGuests, displayName, user.AvatarURL("256"))
+
+	event := &entities.Event{
+		GuildID:      i.GuildID,
+		CreatorID:    user.ID,
+		Title:        title,
+		Description:  desc,
+		Location:     location,
+		MaxSlots:     slots,
+		MaxGuests:    maxGuests,
+		ScheduledAt:  scheduledAt,
+		EndsAt:       endsAt,
+		Timezone:     timezone,
+		WaitlistAuto: settings.WaitlistAuto,
+	}
+	if err := h.publishEvent(s, settings, event, embed); err != nil {
+		key := "errors.create_forum_failed"
+		if errors.Is(err, errCreatePrivateChannel) {
+			key = "errors.create_private_channel_failed"
+		}
+		log.Printf("❌ Publication de la sortie: %v", err)
+		s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+			Content: h.translateFor(i, key, nil),
+		})
+		return
+	}
+
+	if recurrence != "" {
+		err = h.eventUseCase.CreateSeries(ctx, event, recurrence, displayName)
+	} else {
+		err = h.eventUseCase.CreateEvent(ctx, event, displayName)
+	}
+	if err != nil {
 		log.Printf("❌ Erreur lors de la sauvegarde de l'événement: %v", err)
+		unpublishEvent(s, event)
 		s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
-			Content: h.translate("errors.create_event_save_failed", nil),
+			Content: h.translateFor(i, "errors.create_event_save_failed", nil),
 		})
 		return
 	}
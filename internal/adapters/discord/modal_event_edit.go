@@ -3,11 +3,13 @@ package discord
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
 	pkgdiscord "servbot/pkg/discord"
 	"servbot/pkg/tz"
 
@@ -19,118 +21,152 @@ func (h *Handler) HandleEditEvent(s *discordgo.Session, i *discordgo.Interaction
 	ctx := context.Background()
 	event, err := h.eventUseCase.GetEventByMessageID(ctx, i.Message.ID)
 	if err != nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.event_not_found", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_not_found", nil))
 		return
 	}
-	if i.Member.User.ID != event.CreatorID {
-		respondEphemeral(s, i.Interaction, h.translate("errors.only_organizer_can_edit", nil))
+	if !h.eventUseCase.CanManageEvent(event, interactionUserID(i)) {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.only_organizer_can_edit", nil))
 		return
 	}
 	if event.IsEditLocked() {
-		respondEphemeral(s, i.Interaction, h.translate("errors.event_locked", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_locked", nil))
 		return
 	}
 
-	slotsValue := ""
-	if event.MaxSlots > 0 {
-		slotsValue = fmt.Sprintf("%d", event.MaxSlots)
+	if event.SeriesID != 0 {
+		h.respondSeriesEditScope(s, i, event)
+		return
 	}
-	dateValue, timeValue := "", ""
+	h.respondEditEventModal(s, i, event, "edit_event_modal", h.translateFor(i, "ui.modal_edit_event_title", nil))
+}
+
+func (h *Handler) respondEditEventModal(s *discordgo.Session, i *discordgo.InteractionCreate, event *entities.Event, customID, title string) {
+	slotsValue := formatSlots(event.MaxSlots, event.MaxGuests)
+	whenValue := ""
 	if !event.ScheduledAt.IsZero() {
-		t := event.ScheduledAt.In(tz.Paris)
-		dateValue = t.Format("02/01/2006")
-		timeValue = t.Format("15:04")
+		whenValue = pkgdiscord.FormatEventWhen(event.ScheduledAt, event.EndsAt, tz.Location(event.Timezone))
 	}
 
 	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseModal,
 		Data: &discordgo.InteractionResponseData{
-			CustomID: "edit_event_modal",
-			Title:    h.translate("ui.modal_edit_event_title", nil),
+			CustomID: customID,
+			Title:    title,
 			Components: []discordgo.MessageComponent{
 				discordgo.ActionsRow{Components: []discordgo.MessageComponent{
-					discordgo.TextInput{CustomID: "title", Label: h.translate("ui.label_title", nil), Style: discordgo.TextInputShort, Required: true, Value: event.Title, Placeholder: h.translate("ui.placeholder_title", nil)},
+					discordgo.TextInput{CustomID: "title", Label: h.translateFor(i, "ui.label_title", nil), Style: discordgo.TextInputShort, Required: true, Value: event.Title, Placeholder: h.translateFor(i, "ui.placeholder_title", nil)},
 				}},
 				discordgo.ActionsRow{Components: []discordgo.MessageComponent{
-					discordgo.TextInput{CustomID: "desc", Label: h.translate("ui.label_details", nil), Style: discordgo.TextInputParagraph, Required: true, Value: event.Description, Placeholder: h.translate("ui.placeholder_desc", nil)},
+					discordgo.TextInput{CustomID: "desc", Label: h.translateFor(i, "ui.label_details", nil), Style: discordgo.TextInputParagraph, Required: true, Value: event.Description, Placeholder: h.translateFor(i, "ui.placeholder_desc", nil)},
 				}},
 				discordgo.ActionsRow{Components: []discordgo.MessageComponent{
-					discordgo.TextInput{CustomID: "date", Label: h.translate("ui.label_date", nil), Style: discordgo.TextInputShort, Required: true, Value: dateValue, Placeholder: h.translate("ui.placeholder_date", nil)},
+					discordgo.TextInput{CustomID: "when", Label: h.translateFor(i, "ui.label_when", nil), Style: discordgo.TextInputShort, Required: true, Value: whenValue, Placeholder: h.translateFor(i, "ui.placeholder_when", nil)},
 				}},
 				discordgo.ActionsRow{Components: []discordgo.MessageComponent{
-					discordgo.TextInput{CustomID: "time", Label: h.translate("ui.label_time", nil), Style: discordgo.TextInputShort, Required: true, Value: timeValue, Placeholder: h.translate("ui.placeholder_time", nil)},
+					discordgo.TextInput{CustomID: "location", Label: h.translateFor(i, "ui.label_location", nil), Style: discordgo.TextInputShort, Required: false, MaxLength: maxLocationLength, Value: event.Location, Placeholder: h.translateFor(i, "ui.placeholder_location", nil)},
 				}},
 				discordgo.ActionsRow{Components: []discordgo.MessageComponent{
-					discordgo.TextInput{CustomID: "slots", Label: h.translate("ui.label_slots", nil), Style: discordgo.TextInputShort, Required: false, Value: slotsValue, Placeholder: h.translate("ui.placeholder_slots", nil)},
+					discordgo.TextInput{CustomID: "slots", Label: h.translateFor(i, "ui.label_slots", nil), Style: discordgo.TextInputShort, Required: false, Value: slotsValue, Placeholder: h.translateFor(i, "ui.placeholder_slots", nil)},
 				}},
 			},
 		},
 	})
 }
 
+// editModalEvent resolves the edited event: modals opened from the series scope prompt carry
+// the event ID, the plain one is opened from the forum post itself.
+func (h *Handler) editModalEvent(ctx context.Context, i *discordgo.InteractionCreate, customID string) (*entities.Event, error) {
+	for _, prefix := range []string{"edit_event_modal_", "edit_series_modal_"} {
+		if idStr, ok := strings.CutPrefix(customID, prefix); ok {
+			id, err := strconv.ParseUint(idStr, 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			return h.eventUseCase.GetEventByID(ctx, uint(id))
+		}
+	}
+	return h.eventUseCase.GetEventByMessageID(ctx, i.Message.ID)
+}
+
 // HandleEditModalSubmit traite la soumission du modal d'édition.
 func (h *Handler) HandleEditModalSubmit(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	data := i.ModalSubmitData()
-	title, desc, dateStr, timeStr, slotsStr := pkgdiscord.ExtractModalData(data)
+	title, desc, whenStr, location, slotsStr := pkgdiscord.ExtractModalData(data)
 
-	var scheduledAt time.Time
-	if dateStr != "" && timeStr != "" {
-		var parseErr error
-		scheduledAt, parseErr = pkgdiscord.ParseEventDateTime(dateStr, timeStr)
-		if parseErr != nil {
-			if code := domain.Code(parseErr); code != "" {
-				respondEphemeral(s, i.Interaction, h.translate("errors."+code, nil))
-			} else {
-				respondEphemeral(s, i.Interaction, h.translate("errors.generic", nil))
-			}
-			return
-		}
-	}
-	slots, err := parseSlots(slotsStr)
+	slots, maxGuests, err := parseSlots(slotsStr)
 	if err != nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.invalid_slots", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.invalid_slots", nil))
 		return
 	}
 
 	ctx := context.Background()
-	event, err := h.eventUseCase.GetEventByMessageID(ctx, i.Message.ID)
+	event, err := h.editModalEvent(ctx, i, data.CustomID)
 	if err != nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.event_not_found", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_not_found", nil))
 		return
 	}
-	if i.Member.User.ID != event.CreatorID {
-		respondEphemeral(s, i.Interaction, h.translate("errors.only_organizer_can_edit", nil))
+	if !h.eventUseCase.CanManageEvent(event, interactionUserID(i)) {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.only_organizer_can_edit", nil))
 		return
 	}
 	if event.IsEditLocked() {
-		respondEphemeral(s, i.Interaction, h.translate("errors.event_locked", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_locked", nil))
 		return
 	}
 
+	var scheduledAt, endsAt time.Time
+	if whenStr != "" {
+		var parseErr error
+		scheduledAt, endsAt, parseErr = pkgdiscord.ParseEventWhen(whenStr, tz.Location(event.Timezone))
+		if parseErr != nil {
+			if code := domain.Code(parseErr); code != "" {
+				respondEphemeral(s, i.Interaction, h.translateFor(i, "errors."+code, nil))
+			} else {
+				respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.generic", nil))
+			}
+			return
+		}
+	}
+
 	event.Title = title
 	event.Description = desc
+	event.Location = location
 	event.MaxSlots = slots
+	event.MaxGuests = maxGuests
 	if !scheduledAt.IsZero() {
 		event.ScheduledAt = scheduledAt
+		event.EndsAt = endsAt
 	}
 
-	if err := h.eventUseCase.UpdateEvent(ctx, event); err != nil {
+	seriesScope := strings.HasPrefix(data.CustomID, "edit_series_modal_")
+	if seriesScope {
+		err = h.eventUseCase.UpdateSeries(ctx, event, interactionUserID(i))
+	} else {
+		err = h.eventUseCase.UpdateEvent(ctx, event, interactionUserID(i))
+	}
+	if err != nil {
 		switch {
+		case errors.Is(err, domain.ErrNotOrganizer):
+			respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.only_organizer_can_edit", nil))
+		case errors.Is(err, domain.ErrSeriesNotFound), errors.Is(err, domain.ErrSeriesCancelled), errors.Is(err, domain.ErrInvalidGuests):
+			respondEphemeral(s, i.Interaction, h.translateFor(i, "errors."+domain.Code(err), nil))
 		case errors.Is(err, domain.ErrEventAlreadyFinalized):
-			respondEphemeral(s, i.Interaction, h.translate("errors.event_locked", nil))
+			respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_locked", nil))
 		case errors.Is(err, domain.ErrCannotReduceSlots):
-			confirmedParticipants, _ := h.eventUseCase.GetConfirmedParticipants(ctx, event.ID)
-			respondEphemeral(s, i.Interaction, h.translate("errors.cannot_reduce_slots", map[string]any{
-				"Slots":          slots,
-				"ConfirmedCount": len(confirmedParticipants),
+			respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.cannot_reduce_slots", map[string]any{
+				"Slots":      slots,
+				"TakenSeats": event.TakenSeats(),
 			}))
 		default:
 			log.Printf("❌ Erreur lors de la mise à jour de l'événement: %v", err)
-			respondEphemeral(s, i.Interaction, h.translate("errors.generic", nil))
+			respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.generic", nil))
 		}
 		return
 	}
 
-	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
-	respondEphemeral(s, i.Interaction, h.translate("success.generic", nil))
+	if seriesScope {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "success.series_updated", nil))
+		return
+	}
+	respondEphemeral(s, i.Interaction, h.translateFor(i, "success.generic", nil))
 }
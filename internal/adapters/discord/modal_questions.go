@@ -2,11 +2,15 @@ package discord
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -15,16 +19,16 @@ func (h *Handler) HandleAskQuestion(s *discordgo.Session, i *discordgo.Interacti
 	ctx := context.Background()
 	event, err := h.eventUseCase.GetEventByMessageID(ctx, i.Message.ID)
 	if err != nil || event == nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.question_event_not_found", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.question_event_not_found", nil))
 		return
 	}
 	if event.QuestionsThreadID == "" {
-		respondEphemeral(s, i.Interaction, h.translate("errors.question_thread_unavailable", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.question_thread_unavailable", nil))
 		return
 	}
 	userID := interactionUserID(i)
-	if userID == event.CreatorID {
-		respondEphemeral(s, i.Interaction, h.translate("info.ask_question_organizer", nil))
+	if h.eventUseCase.CanManageEvent(event, userID) {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "info.ask_question_organizer", nil))
 		return
 	}
 
@@ -33,16 +37,16 @@ func (h *Handler) HandleAskQuestion(s *discordgo.Session, i *discordgo.Interacti
 		Type: discordgo.InteractionResponseModal,
 		Data: &discordgo.InteractionResponseData{
 			CustomID: customID,
-			Title:    h.translate("ui.modal_ask_question_title", nil),
+			Title:    h.translateFor(i, "ui.modal_ask_question_title", nil),
 			Components: []discordgo.MessageComponent{
 				discordgo.ActionsRow{
 					Components: []discordgo.MessageComponent{
 						discordgo.TextInput{
 							CustomID:    "question",
-							Label:       h.translate("ui.modal_ask_question_label", nil),
+							Label:       h.translateFor(i, "ui.modal_ask_question_label", nil),
 							Style:       discordgo.TextInputParagraph,
 							Required:    true,
-							Placeholder: h.translate("ui.modal_ask_question_placeholder", nil),
+							Placeholder: h.translateFor(i, "ui.modal_ask_question_placeholder", nil),
 						},
 					},
 				},
@@ -52,61 +56,60 @@ func (h *Handler) HandleAskQuestion(s *discordgo.Session, i *discordgo.Interacti
 }
 
 // HandleAnswerQuestion ouvre le modal "Ta réponse" quand l'organisateur clique sur Répondre dans le thread Questions.
+// Le bouton "Répondre + FAQ" ouvre le même modal, la réponse étant en plus publiée dans la FAQ du post forum.
 func (h *Handler) HandleAnswerQuestion(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	customID := i.MessageComponentData().CustomID
-	prefix := "btn_answer_question_"
-	if !strings.HasPrefix(customID, prefix) {
-		return
-	}
-	payload := strings.TrimPrefix(customID, prefix)
-	parts := strings.Split(payload, "_")
-	if len(parts) < 2 {
+	questionIDStr, ok := strings.CutPrefix(i.MessageComponentData().CustomID, "btn_answer_question_")
+	if !ok {
 		return
 	}
-	eventIDStr, memberID := parts[0], parts[1]
-	questionMessageID := ""
-	if len(parts) >= 3 {
-		questionMessageID = parts[2]
-	}
-	if eventIDStr == "" || memberID == "" {
+	questionIDStr, publish := strings.CutPrefix(questionIDStr, "faq_")
+	questionID, err := strconv.ParseUint(questionIDStr, 10, 32)
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.answer_invalid_payload", nil))
 		return
 	}
 
-	eventID, err := strconv.ParseUint(eventIDStr, 10, 32)
+	ctx := context.Background()
+	question, err := h.questionUseCase.GetQuestionByID(ctx, uint(questionID))
 	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.question_not_found", nil))
 		return
 	}
-
-	ctx := context.Background()
-	event, err := h.eventUseCase.GetEventByID(ctx, uint(eventID))
+	event, err := h.eventUseCase.GetEventByID(ctx, question.EventID)
 	if err != nil || event == nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.answer_event_not_found", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.answer_event_not_found", nil))
 		return
 	}
 	userID := interactionUserID(i)
-	if userID == "" || userID != event.CreatorID {
-		respondEphemeral(s, i.Interaction, h.translate("errors.question_only_organizer_can_answer", nil))
+	if !h.eventUseCase.CanManageEvent(event, userID) {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.question_only_organizer_can_answer", nil))
+		return
+	}
+	if question.IsAnswered() {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.question_already_answered", nil))
 		return
 	}
 
-	modalID := fmt.Sprintf("answer_question_modal_%d_%s", event.ID, memberID)
-	if questionMessageID != "" {
-		modalID += "_" + questionMessageID
+	modalID := fmt.Sprintf("answer_question_modal_%d", question.ID)
+	title, placeholder := h.translateFor(i, "ui.modal_answer_title", nil), h.translateFor(i, "ui.modal_answer_placeholder", nil)
+	if publish {
+		modalID = fmt.Sprintf("answer_question_modal_faq_%d", question.ID)
+		title, placeholder = h.translateFor(i, "ui.modal_answer_faq_title", nil), h.translateFor(i, "ui.modal_answer_faq_placeholder", nil)
 	}
 	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseModal,
 		Data: &discordgo.InteractionResponseData{
 			CustomID: modalID,
-			Title:    h.translate("ui.modal_answer_title", nil),
+			Title:    title,
 			Components: []discordgo.MessageComponent{
 				discordgo.ActionsRow{
 					Components: []discordgo.MessageComponent{
 						discordgo.TextInput{
 							CustomID:    "answer",
-							Label:       h.translate("ui.modal_answer_label", nil),
+							Label:       h.translateFor(i, "ui.modal_answer_label", nil),
 							Style:       discordgo.TextInputParagraph,
 							Required:    true,
-							Placeholder: h.translate("ui.modal_answer_placeholder", nil),
+							Placeholder: placeholder,
 						},
 					},
 				},
@@ -138,31 +141,31 @@ func (h *Handler) handleAskQuestionModalSubmit(s *discordgo.Session, i *discordg
 	eventIDStr := strings.TrimPrefix(customID, prefix)
 	eventID, err := strconv.ParseUint(eventIDStr, 10, 32)
 	if err != nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.question_invalid_event_id", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.question_invalid_event_id", nil))
 		return
 	}
 
 	question := extractTextInputValue(data, "question")
 	question = strings.TrimSpace(question)
 	if question == "" {
-		respondEphemeral(s, i.Interaction, h.translate("errors.question_required", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.question_required", nil))
 		return
 	}
 
 	ctx := context.Background()
 	event, err := h.eventUseCase.GetEventByID(ctx, uint(eventID))
 	if err != nil || event == nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.question_event_not_found", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.question_event_not_found", nil))
 		return
 	}
 	if event.QuestionsThreadID == "" {
-		respondEphemeral(s, i.Interaction, h.translate("errors.question_thread_unavailable", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.question_thread_unavailable", nil))
 		return
 	}
 
 	memberID := interactionUserID(i)
 	if memberID == "" {
-		respondEphemeral(s, i.Interaction, h.translate("errors.question_member_unknown", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.question_member_unknown", nil))
 		return
 	}
 
@@ -174,118 +177,168 @@ func (h *Handler) handleAskQuestionModalSubmit(s *discordgo.Session, i *discordg
 		displayName = memberID
 	}
 
-	var contentBuilder strings.Builder
-	contentBuilder.WriteString("<@" + memberID + ">" + h.translate("ui.question_thread_ask_suffix", nil))
-	contentBuilder.WriteString("> " + question + "\n")
-	contentStr := contentBuilder.String()
-
-	msg, err := s.ChannelMessageSend(event.QuestionsThreadID, contentStr)
-	if err != nil || msg == nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.question_send_failed", nil))
+	q, err := h.questionUseCase.AskQuestion(ctx, event.ID, memberID, displayName, question)
+	if err != nil {
+		key := "errors.question_send_failed"
+		if errors.Is(err, domain.ErrEventCancelled) || errors.Is(err, domain.ErrQuestionRequired) {
+			key = "errors." + domain.Code(err)
+		} else {
+			log.Printf("❌ Sauvegarde de la question (event %d): %v", event.ID, err)
+		}
+		respondEphemeral(s, i.Interaction, h.translateFor(i, key, nil))
 		return
 	}
-	components := []discordgo.MessageComponent{
-		discordgo.ActionsRow{
-			Components: []discordgo.MessageComponent{
-				discordgo.Button{
-					Label:    h.translate("ui.btn_reply", nil),
-					Style:    discordgo.PrimaryButton,
-					CustomID: fmt.Sprintf("btn_answer_question_%d_%s_%s", event.ID, memberID, msg.ID),
+
+	locale := h.eventLocale(ctx, s, event)
+	var contentBuilder strings.Builder
+	contentBuilder.WriteString("<@" + memberID + ">" + h.translateIn(locale, "ui.question_thread_ask_suffix", nil))
+	contentBuilder.WriteString("> " + q.Text + "\n")
+
+	msg, err := s.ChannelMessageSendComplex(event.QuestionsThreadID, &discordgo.MessageSend{
+		Content: contentBuilder.String(),
+		Components: []discordgo.MessageComponent{
+			discordgo.ActionsRow{
+				Components: []discordgo.MessageComponent{
+					discordgo.Button{
+						Label:    h.translateIn(locale, "ui.btn_reply", nil),
+						Style:    discordgo.PrimaryButton,
+						CustomID: fmt.Sprintf("btn_answer_question_%d", q.ID),
+					},
+					discordgo.Button{
+						Label:    h.translateIn(locale, "ui.btn_reply_faq", nil),
+						Style:    discordgo.SecondaryButton,
+						CustomID: fmt.Sprintf("btn_answer_question_faq_%d", q.ID),
+					},
 				},
 			},
 		},
-	}
-	_, err = s.ChannelMessageEditComplex(&discordgo.MessageEdit{
-		ID:         msg.ID,
-		Channel:    event.QuestionsThreadID,
-		Content:    &contentStr,
-		Components: &components,
 	})
-	if err != nil {
-		log.Printf("❌ Ajout bouton Répondre (question thread): %v", err)
+	if err != nil || msg == nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.question_send_failed", nil))
+		return
+	}
+	if err := h.questionUseCase.AttachThreadMessage(ctx, q.ID, msg.ID); err != nil {
+		log.Printf("❌ Sauvegarde du message de la question %d: %v", q.ID, err)
 	}
 
-	respondEphemeral(s, i.Interaction, h.translate("success.question_sent", nil))
+	respondEphemeral(s, i.Interaction, h.translateFor(i, "success.question_sent", nil))
 }
 
-// extractQuestionFromThreadMessage extracts the question text from the thread message.
-// Supports both FR (" te demande :\n") and EN (" asks:\n") suffixes.
-func extractQuestionFromThreadMessage(content string) string {
-	for _, suffix := range []string{" te demande :\n", " asks:\n"} {
-		idx := strings.Index(content, suffix)
-		if idx != -1 {
-			block := strings.TrimSpace(content[idx+len(suffix):])
-			block = strings.TrimPrefix(block, "> ")
-			block = strings.ReplaceAll(block, "\n> ", "\n")
-			return strings.TrimSpace(block)
-		}
+// handleAnswerQuestionModalSubmit enregistre la réponse et l'envoie en MP au membre, précédée de sa question.
+func (h *Handler) handleAnswerQuestionModalSubmit(s *discordgo.Session, i *discordgo.InteractionCreate, data discordgo.ModalSubmitInteractionData) {
+	questionIDStr, publish := strings.CutPrefix(strings.TrimPrefix(data.CustomID, "answer_question_modal_"), "faq_")
+	questionID, err := strconv.ParseUint(questionIDStr, 10, 32)
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.answer_invalid_payload", nil))
+		return
 	}
-	return ""
-}
 
-// handleAnswerQuestionModalSubmit envoie la réponse en MP au membre, précédée de sa question.
-func (h *Handler) handleAnswerQuestionModalSubmit(s *discordgo.Session, i *discordgo.InteractionCreate, data discordgo.ModalSubmitInteractionData) {
-	customID := data.CustomID
-	prefix := "answer_question_modal_"
-	if !strings.HasPrefix(customID, prefix) {
+	ctx := context.Background()
+	question, err := h.questionUseCase.AnswerQuestion(ctx, uint(questionID), interactionUserID(i), extractTextInputValue(data, "answer"), publish)
+	if err != nil {
+		key := "errors.generic"
+		switch {
+		case errors.Is(err, domain.ErrNotOrganizer):
+			key = "errors.question_only_organizer_can_answer"
+		case errors.Is(err, domain.ErrEventNotFound):
+			key = "errors.answer_event_not_found"
+		case errors.Is(err, domain.ErrQuestionNotFound), errors.Is(err, domain.ErrAnswerRequired), errors.Is(err, domain.ErrQuestionAlreadyAnswered):
+			key = "errors." + domain.Code(err)
+		default:
+			log.Printf("❌ Sauvegarde de la réponse (question %d): %v", questionID, err)
+		}
+		respondEphemeral(s, i.Interaction, h.translateFor(i, key, nil))
 		return
 	}
-	payload := strings.TrimPrefix(customID, prefix)
-	parts := strings.Split(payload, "_")
-	if len(parts) < 2 {
-		respondEphemeral(s, i.Interaction, h.translate("errors.answer_invalid_payload", nil))
+	event, err := h.eventUseCase.GetEventByID(ctx, question.EventID)
+	if err != nil || event == nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.answer_event_not_found", nil))
 		return
 	}
-	eventIDStr, memberID := parts[0], parts[1]
-	questionMessageID := ""
-	if len(parts) >= 3 {
-		questionMessageID = parts[2]
+
+	locale := h.userLocale(ctx, question.AskerID)
+	var dmBuilder strings.Builder
+	if link := messageLink(s, event.GuildID, event.ChannelID, event.MessageID); link != "" {
+		dmBuilder.WriteString(h.translateIn(locale, "ui.dm_answer_header_link", map[string]any{"EventTitle": event.Title, "Link": link}))
+	} else {
+		dmBuilder.WriteString(h.translateIn(locale, "ui.dm_answer_header", map[string]any{"EventTitle": event.Title}))
 	}
-	eventID, err := strconv.ParseUint(eventIDStr, 10, 32)
-	if err != nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.answer_invalid_event_id", nil))
+	dmBuilder.WriteString(h.translateIn(locale, "ui.dm_answer_your_question", nil))
+	dmBuilder.WriteString(question.Text + "\n\n")
+	dmBuilder.WriteString(h.translateIn(locale, "ui.dm_answer_label", nil))
+	dmBuilder.WriteString(question.Answer)
+
+	sendDM(s, question.AskerID, dmBuilder.String())
+	h.markQuestionAnswered(s, event, question)
+	if question.IsPublished() {
+		h.updateFAQ(ctx, s, event)
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "success.answer_sent_published", nil))
 		return
 	}
+	respondEphemeral(s, i.Interaction, h.translateFor(i, "success.answer_sent", nil))
+}
 
-	answer := extractTextInputValue(data, "answer")
-	answer = strings.TrimSpace(answer)
-	if answer == "" {
-		respondEphemeral(s, i.Interaction, h.translate("errors.answer_required", nil))
+// markQuestionAnswered replaces the Répondre button of the thread message so the question is not answered twice.
+func (h *Handler) markQuestionAnswered(s *discordgo.Session, event *entities.Event, question *entities.Question) {
+	if event.QuestionsThreadID == "" || question.ThreadMessageID == "" {
 		return
 	}
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    h.translateIn(h.eventLocale(context.Background(), s, event), "ui.btn_answered", nil),
+					Style:    discordgo.SuccessButton,
+					CustomID: fmt.Sprintf("btn_answer_question_%d", question.ID),
+					Disabled: true,
+				},
+			},
+		},
+	}
+	_, err := s.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		ID:         question.ThreadMessageID,
+		Channel:    event.QuestionsThreadID,
+		Components: &components,
+	})
+	if err != nil {
+		log.Printf("❌ Mise à jour du message de la question %d: %v", question.ID, err)
+	}
+}
 
+// HandleQuestionsCommand lists the unanswered questions of the event (/questions, from the private channel).
+func (h *Handler) HandleQuestionsCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	ctx := context.Background()
-	event, err := h.eventUseCase.GetEventByID(ctx, uint(eventID))
-	if err != nil || event == nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.answer_event_not_found", nil))
+	event, err := h.eventUseCase.GetEventByPrivateChannelID(ctx, i.ChannelID)
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.questions_command_wrong_channel", nil))
 		return
 	}
-	userID := interactionUserID(i)
-	if userID == "" || userID != event.CreatorID {
-		respondEphemeral(s, i.Interaction, h.translate("errors.question_only_organizer_can_answer", nil))
+	questions, err := h.questionUseCase.GetUnansweredQuestions(ctx, event.ID, interactionUserID(i))
+	if err != nil {
+		key := "errors.generic"
+		if errors.Is(err, domain.ErrNotOrganizer) {
+			key = "errors.only_organizer_can_list_questions"
+		}
+		respondEphemeral(s, i.Interaction, h.translateFor(i, key, nil))
 		return
 	}
-
-	questionText := ""
-	if event.QuestionsThreadID != "" && questionMessageID != "" {
-		if qMsg, err := s.ChannelMessage(event.QuestionsThreadID, questionMessageID); err == nil && qMsg != nil {
-			questionText = extractQuestionFromThreadMessage(qMsg.Content)
-		}
+	if len(questions) == 0 {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "info.no_unanswered_question", nil))
+		return
 	}
 
-	var dmBuilder strings.Builder
-	if link := h.messageLink(event.ChannelID, event.MessageID); link != "" {
-		dmBuilder.WriteString(h.translate("ui.dm_answer_header_link", map[string]any{"EventTitle": event.Title, "Link": link}))
-	} else {
-		dmBuilder.WriteString(h.translate("ui.dm_answer_header", map[string]any{"EventTitle": event.Title}))
-	}
-	if questionText != "" {
-		dmBuilder.WriteString(h.translate("ui.dm_answer_your_question", nil))
-		dmBuilder.WriteString(questionText + "\n\n")
+	var b strings.Builder
+	b.WriteString(h.translateFor(i, "ui.unanswered_questions_header", map[string]any{"Count": len(questions)}))
+	for _, q := range questions {
+		line := fmt.Sprintf("\n- <@%s> : %s", q.AskerID, q.Text)
+		if link := messageLink(s, event.GuildID, event.QuestionsThreadID, q.ThreadMessageID); link != "" {
+			line += fmt.Sprintf(" ([%s](%s))", h.translateFor(i, "ui.btn_reply", nil), link)
+		}
+		if b.Len()+len(line) > 1900 {
+			b.WriteString("\n…")
+			break
+		}
+		b.WriteString(line)
 	}
-	dmBuilder.WriteString(h.translate("ui.dm_answer_label", nil))
-	dmBuilder.WriteString(answer)
-
-	sendDM(s, memberID, dmBuilder.String())
-	respondEphemeral(s, i.Interaction, h.translate("success.answer_sent", nil))
+	respondEphemeral(s, i.Interaction, b.String())
 }
@@ -0,0 +1,286 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+	pkgdiscord "servbot/pkg/discord"
+	"servbot/pkg/tz"
+)
+
+const maxMyEvents = 5 // one row of buttons per event, 5 rows max per message
+const maxButtonLabelLen = 80
+
+// HandleMyEventsCommand lists the member's upcoming outings with their status (/mes-sorties).
+func (h *Handler) HandleMyEventsCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: h.myEventsData(context.Background(), s, i, ""),
+	})
+}
+
+// HandleMyEventsBack shows the list again from the switch menu.
+func (h *Handler) HandleMyEventsBack(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	h.updateMyEvents(s, i, "")
+}
+
+// HandleMyEventsLeave unregisters the member from the event of the clicked row.
+func (h *Handler) HandleMyEventsLeave(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	eventID, ok := parseParticipantID(i.MessageComponentData().CustomID, "btn_my_leave_")
+	if !ok {
+		return
+	}
+	event, err := h.eventUseCase.GetEventByID(ctx, eventID)
+	if err != nil {
+		h.updateMyEvents(s, i, h.translateFor(i, "errors.event_not_found", nil))
+		return
+	}
+	userID := interactionUserID(i)
+	if userID == event.CreatorID || !h.leaveEvent(ctx, event, userID) {
+		h.updateMyEvents(s, i, h.translateFor(i, "errors.participant_not_found", nil))
+		return
+	}
+	_ = s.MessageReactionRemove(event.ChannelID, event.MessageID, reactionJoinEmoji, userID)
+	h.updateMyEvents(s, i, h.translateFor(i, "success.my_events_left", map[string]any{"EventTitle": event.Title}))
+}
+
+// HandleMyEventsSwitch offers the open outings of the guild the member could move to.
+func (h *Handler) HandleMyEventsSwitch(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	fromID, ok := parseParticipantID(i.MessageComponentData().CustomID, "btn_my_switch_")
+	if !ok {
+		return
+	}
+	from, err := h.eventUseCase.GetEventByID(ctx, fromID)
+	if err != nil {
+		h.updateMyEvents(s, i, h.translateFor(i, "errors.event_not_found", nil))
+		return
+	}
+	userID := interactionUserID(i)
+	now := time.Now()
+	registrations, err := h.participantUseCase.GetUpcomingRegistrations(ctx, i.GuildID, userID, now)
+	if err != nil {
+		log.Printf("❌ Liste des sorties (user %s): %v", userID, err)
+		h.updateMyEvents(s, i, h.translateFor(i, "errors.generic", nil))
+		return
+	}
+	registered := make(map[uint]bool, len(registrations))
+	for _, r := range registrations {
+		registered[r.Event.ID] = true
+	}
+	events, err := h.eventUseCase.GetEventsByGuildID(ctx, i.GuildID)
+	if err != nil {
+		log.Printf("❌ Sorties du serveur %s: %v", i.GuildID, err)
+		h.updateMyEvents(s, i, h.translateFor(i, "errors.generic", nil))
+		return
+	}
+
+	var memberRoles []string
+	if i.Member != nil {
+		memberRoles = i.Member.Roles
+	}
+	options := make([]discordgo.SelectMenuOption, 0, maxSelectOptions)
+	for _, e := range events {
+		if len(options) == maxSelectOptions {
+			break
+		}
+		if registered[e.ID] || e.CreatorID == userID || e.MessageID == "" || e.IsCancelled() || e.HasStarted() || e.HasEnded() || !e.IsRoleAllowed(memberRoles) {
+			continue
+		}
+		option := discordgo.SelectMenuOption{
+			Label: truncateLabel(e.Title, maxSelectLabelLen),
+			Value: fmt.Sprintf("switch_%d", e.ID),
+		}
+		if !e.ScheduledAt.IsZero() {
+			option.Description = pkgdiscord.FormatEventWhen(e.ScheduledAt, e.EndsAt, tz.Location(e.Timezone))
+		}
+		options = append(options, option)
+	}
+	if len(options) == 0 {
+		h.updateMyEvents(s, i, h.translateFor(i, "info.my_events_no_switch", nil))
+		return
+	}
+
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content: h.translateFor(i, "ui.my_events_switch_intro", map[string]any{"EventTitle": from.Title}),
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.SelectMenu{
+							CustomID:    fmt.Sprintf("select_my_switch_%d", from.ID),
+							Placeholder: h.translateFor(i, "ui.my_events_switch_placeholder", nil),
+							Options:     options,
+						},
+					},
+				},
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.Button{
+							Label:    h.translateFor(i, "ui.btn_back", nil),
+							Style:    discordgo.SecondaryButton,
+							CustomID: "btn_my_back",
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+// HandleMyEventsSwitchSelect registers the member to the chosen outing, then unregisters them
+// from the one they left; they keep their place if the new registration fails.
+func (h *Handler) HandleMyEventsSwitchSelect(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	data := i.MessageComponentData()
+	fromID, ok := parseParticipantID(data.CustomID, "select_my_switch_")
+	if !ok || len(data.Values) == 0 {
+		return
+	}
+	toID, ok := parseParticipantID(data.Values[0], "switch_")
+	if !ok {
+		h.updateMyEvents(s, i, h.translateFor(i, "errors.invalid_selection", nil))
+		return
+	}
+	from, err := h.eventUseCase.GetEventByID(ctx, fromID)
+	if err != nil {
+		h.updateMyEvents(s, i, h.translateFor(i, "errors.event_not_found", nil))
+		return
+	}
+	to, err := h.eventUseCase.GetEventByID(ctx, toID)
+	if err != nil {
+		h.updateMyEvents(s, i, h.translateFor(i, "errors.event_not_found", nil))
+		return
+	}
+
+	userID := interactionUserID(i)
+	username := resolveDisplayName(i.Member)
+	if username == "" {
+		username = userID
+	}
+	var roleIDs []string
+	if i.Member != nil {
+		roleIDs = i.Member.Roles
+	}
+	reply, err := h.joinEvent(ctx, to, userID, username, roleIDs)
+	if errors.Is(err, domain.ErrRoleNotAllowed) {
+		h.updateMyEvents(s, i, h.roleRequiredMessage(s, string(i.Locale), to))
+		return
+	}
+	if err != nil {
+		if reply == "" {
+			if !errors.Is(err, domain.ErrEventStarted) && !errors.Is(err, domain.ErrEventNotFound) {
+				log.Printf("❌ Changement de sortie (user %s, event %d): %v", userID, to.ID, err)
+			}
+			reply = h.translateFor(i, "errors.generic", nil)
+		}
+		h.updateMyEvents(s, i, reply)
+		return
+	}
+	if h.leaveEvent(ctx, from, userID) {
+		_ = s.MessageReactionRemove(from.ChannelID, from.MessageID, reactionJoinEmoji, userID)
+	}
+	h.updateMyEvents(s, i, h.translateFor(i, "success.my_events_switched", map[string]any{
+		"From":  from.Title,
+		"To":    to.Title,
+		"Reply": reply,
+	}))
+}
+
+func (h *Handler) updateMyEvents(s *discordgo.Session, i *discordgo.InteractionCreate, notice string) {
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: h.myEventsData(context.Background(), s, i, notice),
+	})
+}
+
+// myEventsData renders the list of the member's upcoming outings, under notice if any, with a
+// row of Leave / Switch buttons per outing they do not organize.
+func (h *Handler) myEventsData(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, notice string) *discordgo.InteractionResponseData {
+	data := &discordgo.InteractionResponseData{
+		Flags:      discordgo.MessageFlagsEphemeral,
+		Components: []discordgo.MessageComponent{},
+	}
+	userID := interactionUserID(i)
+	registrations, err := h.participantUseCase.GetUpcomingRegistrations(ctx, i.GuildID, userID, time.Now())
+	if err != nil {
+		log.Printf("❌ Liste des sorties (user %s): %v", userID, err)
+		data.Content = h.translateFor(i, "errors.generic", nil)
+		return data
+	}
+
+	var b strings.Builder
+	if notice != "" {
+		b.WriteString(notice + "\n\n")
+	}
+	if len(registrations) == 0 {
+		b.WriteString(h.translateFor(i, "info.my_events_empty", nil))
+		data.Content = b.String()
+		return data
+	}
+	b.WriteString(h.translateFor(i, "ui.my_events_title", nil))
+	for n, r := range registrations {
+		if n == maxMyEvents {
+			b.WriteString("\n" + h.translateFor(i, "ui.my_events_truncated", map[string]any{"Max": maxMyEvents}))
+			break
+		}
+		b.WriteString("\n" + h.myEventsLine(s, i, &r))
+		if r.Event.CreatorID == userID {
+			continue
+		}
+		title := truncateLabel(r.Event.Title, maxButtonLabelLen/2)
+		data.Components = append(data.Components, discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    truncateLabel(h.translateFor(i, "ui.btn_my_leave", map[string]any{"EventTitle": title}), maxButtonLabelLen),
+					Style:    discordgo.DangerButton,
+					CustomID: fmt.Sprintf("btn_my_leave_%d", r.Event.ID),
+				},
+				discordgo.Button{
+					Label:    h.translateFor(i, "ui.btn_my_switch", nil),
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("btn_my_switch_%d", r.Event.ID),
+				},
+			},
+		})
+	}
+	data.Content = b.String()
+	return data
+}
+
+func (h *Handler) myEventsLine(s *discordgo.Session, i *discordgo.InteractionCreate, r *entities.Registration) string {
+	title := "**" + r.Event.Title + "**"
+	if link := messageLink(s, r.Event.GuildID, r.Event.ChannelID, r.Event.MessageID); link != "" {
+		title = "[" + r.Event.Title + "](" + link + ")"
+	}
+	date := h.translateFor(i, "ui.my_events_no_date", nil)
+	if !r.Event.ScheduledAt.IsZero() {
+		date = pkgdiscord.FormatTimestamp(r.Event.ScheduledAt)
+	}
+
+	var status string
+	switch {
+	case r.Event.CreatorID == r.Participant.UserID:
+		status = h.translateFor(i, "ui.my_events_status_organizer", nil)
+	case r.Participant.Status == domain.StatusWaitlist:
+		status = h.translateFor(i, "ui.my_events_status_waitlist", map[string]any{"Position": r.WaitlistPosition})
+	case r.Participant.Status == domain.StatusOffered:
+		status = h.translateFor(i, "ui.my_events_status_offered", map[string]any{"Deadline": pkgdiscord.FormatTimestamp(r.Participant.OfferExpiresAt)})
+	default:
+		status = h.translateFor(i, "ui.my_events_status_confirmed", nil)
+	}
+	if r.Participant.Guests > 0 {
+		status += fmt.Sprintf(" (+%d)", r.Participant.Guests)
+	}
+	return fmt.Sprintf("• %s — %s — %s", title, date, status)
+}
@@ -12,7 +12,6 @@ import (
 	"servbot/internal/domain"
 	"servbot/internal/domain/entities"
 	pkgdiscord "servbot/pkg/discord"
-	"servbot/pkg/tz"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -31,14 +30,18 @@ func shouldGrantPrivateChannelOnPromote(event *entities.Event, now time.Time) bo
 	return event != nil && event.PrivateChannelID != "" && (event.IsFinalized() || isCasB(event.ScheduledAt, now))
 }
 
-func (h *Handler) messageLink(channelID, messageID string) string {
-	if h.guildID == "" || channelID == "" || messageID == "" {
+func messageLink(s *discordgo.Session, guildID, channelID, messageID string) string {
+	if channelID == "" || messageID == "" {
 		return ""
 	}
-	return fmt.Sprintf("https://discord.com/channels/%s/%s/%s", h.guildID, channelID, messageID)
+	guildID = eventGuildID(s, guildID, channelID)
+	if guildID == "" {
+		return ""
+	}
+	return fmt.Sprintf("https://discord.com/channels/%s/%s/%s", guildID, channelID, messageID)
 }
 
-func (h *Handler) buildOrganizerTriDMContent(event *eventWithParticipants) string {
+func (h *Handler) buildOrganizerTriDMContent(s *discordgo.Session, locale string, event *eventWithParticipants) string {
 	participantsSansOrga := make([]entities.Participant, 0, len(event.Participants))
 	for _, p := range event.Participants {
 		if p.UserID != event.CreatorID {
@@ -47,36 +50,38 @@ func (h *Handler) buildOrganizerTriDMContent(event *eventWithParticipants) strin
 	}
 	confirmed, waitlist := pkgdiscord.FormatParticipants(participantsSansOrga)
 	var b strings.Builder
-	if link := h.messageLink(event.ChannelID, event.MessageID); link != "" {
-		b.WriteString(h.translate("ui.dm_organizer_tri_title_link", map[string]any{"EventTitle": event.Title, "Link": link}))
+	if link := messageLink(s, event.GuildID, event.ChannelID, event.MessageID); link != "" {
+		b.WriteString(h.translateIn(locale, "ui.dm_organizer_tri_title_link", map[string]any{"EventTitle": event.Title, "Link": link}))
 	} else {
-		b.WriteString(h.translate("ui.dm_organizer_tri_title", map[string]any{"EventTitle": event.Title}))
+		b.WriteString(h.translateIn(locale, "ui.dm_organizer_tri_title", map[string]any{"EventTitle": event.Title}))
 	}
 	if !event.ScheduledAt.IsZero() {
-		b.WriteString(h.translate("ui.dm_organizer_tri_date", map[string]any{"Date": event.ScheduledAt.In(tz.Paris).Format("02/01/2006 15:04")}))
+		b.WriteString(h.translateIn(locale, "ui.dm_organizer_tri_date", map[string]any{"Date": pkgdiscord.FormatTimestamp(event.ScheduledAt)}))
 	}
 	if len(confirmed) > 0 {
-		b.WriteString(h.translate("ui.dm_organizer_tri_confirmed_header", nil))
+		b.WriteString(h.translateIn(locale, "ui.dm_organizer_tri_confirmed_header", nil))
 		for _, line := range confirmed {
 			b.WriteString(line + "\n")
 		}
 		b.WriteString("\n")
 	}
 	if len(waitlist) > 0 {
-		b.WriteString(h.translate("ui.dm_organizer_tri_waitlist_header", nil))
+		b.WriteString(h.translateIn(locale, "ui.dm_organizer_tri_waitlist_header", nil))
 		for _, line := range waitlist {
 			b.WriteString(line + "\n")
 		}
 	}
-	b.WriteString(h.translate("ui.dm_organizer_tri_footer", nil))
+	b.WriteString(h.translateIn(locale, "ui.dm_organizer_tri_footer", nil))
 	return b.String()
 }
 
 type eventWithParticipants struct {
 	ID                          uint
+	GuildID                     string
 	MessageID                   string
 	ChannelID                   string
 	CreatorID                   string
+	CoHostIDs                   []string
 	Title                       string
 	Description                 string
 	MaxSlots                    int
@@ -86,17 +91,25 @@ type eventWithParticipants struct {
 	Participants                []entities.Participant
 }
 
+// sendOrganizerValidationDM sends the validation DM to the organizer and their co-hosts.
 func (h *Handler) sendOrganizerValidationDM(s *discordgo.Session, event *eventWithParticipants) error {
-	ch, err := s.UserChannelCreate(event.CreatorID)
+	return sendToHosts(event.ID, event.CreatorID, event.CoHostIDs, func(hostID string) error {
+		return h.sendOrganizerValidationDMTo(s, hostID, event)
+	})
+}
+
+func (h *Handler) sendOrganizerValidationDMTo(s *discordgo.Session, hostID string, event *eventWithParticipants) error {
+	ch, err := s.UserChannelCreate(hostID)
 	if err != nil || ch == nil {
 		return fmt.Errorf("create organizer DM channel: %w", err)
 	}
-	content := h.buildOrganizerTriDMContent(event)
+	locale := h.userLocale(context.Background(), hostID)
+	content := h.buildOrganizerTriDMContent(s, locale, event)
 	components := []discordgo.MessageComponent{
 		discordgo.ActionsRow{
 			Components: []discordgo.MessageComponent{
 				discordgo.Button{
-					Label:    h.translate("ui.btn_finalize_step1", nil),
+					Label:    h.translateIn(locale, "ui.btn_finalize_step1", nil),
 					Style:    discordgo.SuccessButton,
 					CustomID: fmt.Sprintf("btn_organizer_finalize_%d", event.ID),
 				},
@@ -110,29 +123,37 @@ func (h *Handler) sendOrganizerValidationDM(s *discordgo.Session, event *eventWi
 	return err
 }
 
-func (h *Handler) sendOrganizerAcceptRefuseDM(s *discordgo.Session, eventTitle, organizerID, channelID, messageID string, participant *entities.Participant) error {
-	ch, err := s.UserChannelCreate(organizerID)
+// sendOrganizerAcceptRefuseDM asks the organizer and their co-hosts to accept or refuse a late registration.
+func (h *Handler) sendOrganizerAcceptRefuseDM(s *discordgo.Session, event *entities.Event, participant *entities.Participant) error {
+	return sendToHosts(event.ID, event.CreatorID, event.CoHostIDs, func(hostID string) error {
+		return h.sendOrganizerAcceptRefuseDMTo(s, hostID, event, participant)
+	})
+}
+
+func (h *Handler) sendOrganizerAcceptRefuseDMTo(s *discordgo.Session, hostID string, event *entities.Event, participant *entities.Participant) error {
+	ch, err := s.UserChannelCreate(hostID)
 	if err != nil || ch == nil {
 		return fmt.Errorf("create organizer DM channel: %w", err)
 	}
+	locale := h.userLocale(context.Background(), hostID)
 	var content string
-	data := map[string]any{"EventTitle": eventTitle, "UserID": participant.UserID, "Username": participant.Username}
-	if link := h.messageLink(channelID, messageID); link != "" {
+	data := map[string]any{"EventTitle": event.Title, "UserID": participant.UserID, "Username": participant.Username}
+	if link := messageLink(s, event.GuildID, event.ChannelID, event.MessageID); link != "" {
 		data["Link"] = link
-		content = h.translate("ui.dm_organizer_new_registration_link", data)
+		content = h.translateIn(locale, "ui.dm_organizer_new_registration_link", data)
 	} else {
-		content = h.translate("ui.dm_organizer_new_registration", data)
+		content = h.translateIn(locale, "ui.dm_organizer_new_registration", data)
 	}
 	components := []discordgo.MessageComponent{
 		discordgo.ActionsRow{
 			Components: []discordgo.MessageComponent{
 				discordgo.Button{
-					Label:    h.translate("ui.btn_accept", nil),
+					Label:    h.translateIn(locale, "ui.btn_accept", nil),
 					Style:    discordgo.SuccessButton,
 					CustomID: fmt.Sprintf("btn_organizer_accept_%d", participant.ID),
 				},
 				discordgo.Button{
-					Label:    h.translate("ui.btn_refuse", nil),
+					Label:    h.translateIn(locale, "ui.btn_refuse", nil),
 					Style:    discordgo.DangerButton,
 					CustomID: fmt.Sprintf("btn_organizer_refuse_%d", participant.ID),
 				},
@@ -146,29 +167,37 @@ func (h *Handler) sendOrganizerAcceptRefuseDM(s *discordgo.Session, eventTitle,
 	return err
 }
 
+// sendWaitlistSlotFreedDM offers the organizer and their co-hosts to promote next.
 func (h *Handler) sendWaitlistSlotFreedDM(s *discordgo.Session, event *entities.Event, next *entities.Participant) error {
-	ch, err := s.UserChannelCreate(event.CreatorID)
+	return sendToHosts(event.ID, event.CreatorID, event.CoHostIDs, func(hostID string) error {
+		return h.sendWaitlistSlotFreedDMTo(s, hostID, event, next)
+	})
+}
+
+func (h *Handler) sendWaitlistSlotFreedDMTo(s *discordgo.Session, hostID string, event *entities.Event, next *entities.Participant) error {
+	ch, err := s.UserChannelCreate(hostID)
 	if err != nil || ch == nil {
 		return fmt.Errorf("create organizer DM channel: %w", err)
 	}
+	locale := h.userLocale(context.Background(), hostID)
 	data := map[string]any{"EventTitle": event.Title, "UserID": next.UserID, "Username": next.Username}
 	var content string
-	if link := h.messageLink(event.ChannelID, event.MessageID); link != "" {
+	if link := messageLink(s, event.GuildID, event.ChannelID, event.MessageID); link != "" {
 		data["Link"] = link
-		content = h.translate("ui.dm_waitlist_slot_freed_link", data)
+		content = h.translateIn(locale, "ui.dm_waitlist_slot_freed_link", data)
 	} else {
-		content = h.translate("ui.dm_waitlist_slot_freed", data)
+		content = h.translateIn(locale, "ui.dm_waitlist_slot_freed", data)
 	}
 	components := []discordgo.MessageComponent{
 		discordgo.ActionsRow{
 			Components: []discordgo.MessageComponent{
 				discordgo.Button{
-					Label:    h.translate("ui.btn_accept", nil),
+					Label:    h.translateIn(locale, "ui.btn_accept", nil),
 					Style:    discordgo.SuccessButton,
 					CustomID: fmt.Sprintf("btn_waitlist_slot_accept_%d", next.ID),
 				},
 				discordgo.Button{
-					Label:    h.translate("ui.btn_ignore", nil),
+					Label:    h.translateIn(locale, "ui.btn_ignore", nil),
 					Style:    discordgo.SecondaryButton,
 					CustomID: fmt.Sprintf("btn_waitlist_slot_ignore_%d", next.ID),
 				},
@@ -188,9 +217,11 @@ func eventToEventWithParticipants(e *entities.Event) *eventWithParticipants {
 	}
 	return &eventWithParticipants{
 		ID:                          e.ID,
+		GuildID:                     e.GuildID,
 		MessageID:                   e.MessageID,
 		ChannelID:                   e.ChannelID,
 		CreatorID:                   e.CreatorID,
+		CoHostIDs:                   e.CoHostIDs,
 		Title:                       e.Title,
 		Description:                 e.Description,
 		MaxSlots:                    e.MaxSlots,
@@ -231,8 +262,7 @@ func (h *Handler) HandleOrganizerFinalizeStep1(s *discordgo.Session, i *discordg
 		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
 	})
 
-	event, err := h.eventUseCase.FinalizeOrganizerStep1(ctx, uint(eventID), userID)
-	if err != nil {
+	if _, err := h.eventUseCase.FinalizeOrganizerStep1(ctx, uint(eventID), userID); err != nil {
 		key := "errors.finalize_generic"
 		switch {
 		case errors.Is(err, domain.ErrNotOrganizer):
@@ -241,75 +271,18 @@ func (h *Handler) HandleOrganizerFinalizeStep1(s *discordgo.Session, i *discordg
 			key = "errors.finalize_already_done"
 		}
 		s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
-			Content: h.translate(key, nil),
+			Content: h.translateFor(i, key, nil),
 			Flags:   discordgo.MessageFlagsEphemeral,
 		})
 		return
 	}
 
-	for _, p := range event.Participants {
-		if p.Status != domain.StatusConfirmed || p.UserID == event.CreatorID {
-			continue
-		}
-		var dmContent string
-		if link := h.messageLink(event.ChannelID, event.MessageID); link != "" {
-			dmContent = h.translate("dm.finalize_confirmed_with_link", map[string]any{
-				"EventTitle": event.Title,
-				"Link":       link,
-			})
-		} else {
-			dmContent = h.translate("dm.finalize_confirmed_no_link", map[string]any{
-				"EventTitle": event.Title,
-			})
-		}
-		if !event.ScheduledAt.IsZero() {
-			dmContent += "\n" + h.translate("ui.dm_date_line", map[string]any{"Date": event.ScheduledAt.In(tz.Paris).Format("02/01/2006 15:04")})
-		}
-		dmContent += h.translate("dm.finalize_confirmed_footer", nil)
-		sendDM(s, p.UserID, dmContent)
-		grantPrivateChannelAccess(s, event.PrivateChannelID, p.UserID)
-	}
-
-	if h.guildID != "" && !event.ScheduledAt.IsZero() {
-		h.createDiscordScheduledEvent(s, event)
-	}
-
-	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
-
 	s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
-		Content: h.translate("success.finalize_step1", nil),
+		Content: h.translateFor(i, "success.finalize_step1", nil),
 		Flags:   discordgo.MessageFlagsEphemeral,
 	})
 }
 
-func (h *Handler) createDiscordScheduledEvent(s *discordgo.Session, event *entities.Event) {
-	startTime := event.ScheduledAt
-	endTime := startTime.Add(2 * time.Hour)
-
-	location := event.Description
-	if len(location) > 100 {
-		location = location[:97] + "..."
-	}
-	if location == "" {
-		location = h.translate("ui.calendar_location_placeholder", nil)
-	}
-
-	_, err := s.GuildScheduledEventCreate(h.guildID, &discordgo.GuildScheduledEventParams{
-		Name:               event.Title,
-		Description:        event.Description,
-		ScheduledStartTime: &startTime,
-		ScheduledEndTime:   &endTime,
-		PrivacyLevel:       discordgo.GuildScheduledEventPrivacyLevelGuildOnly,
-		EntityType:         discordgo.GuildScheduledEventEntityTypeExternal,
-		EntityMetadata: &discordgo.GuildScheduledEventEntityMetadata{
-			Location: location,
-		},
-	})
-	if err != nil {
-		log.Printf("❌ Création événement calendrier Discord (event %d): %v", event.ID, err)
-	}
-}
-
 func (h *Handler) HandleOrganizerAccept(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	ctx := context.Background()
 	customID := i.MessageComponentData().CustomID
@@ -331,11 +304,11 @@ func (h *Handler) HandleOrganizerAccept(s *discordgo.Session, i *discordgo.Inter
 		return
 	}
 	userID := interactionUserID(i)
-	if event.CreatorID != userID {
+	if !h.eventUseCase.CanManageEvent(event, userID) {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 				Data: &discordgo.InteractionResponseData{
-					Content: h.translate("errors.only_organizer_can_accept_candidate", nil),
+					Content: h.translateFor(i, "errors.only_organizer_can_accept_candidate", nil),
 					Flags:   discordgo.MessageFlagsEphemeral,
 				},
 		})
@@ -348,26 +321,25 @@ func (h *Handler) HandleOrganizerAccept(s *discordgo.Session, i *discordgo.Inter
 			s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 				Type: discordgo.InteractionResponseChannelMessageWithSource,
 				Data: &discordgo.InteractionResponseData{
-					Content: h.translate("errors.generic", nil),
+					Content: h.translateFor(i, "errors.generic", nil),
 					Flags:   discordgo.MessageFlagsEphemeral,
 				},
 			})
 			return
 		}
+		// The ParticipantPromoted subscriber notifies the participant and opens the channel.
 		participant = promoted
+	} else {
+		sendDM(s, participant.UserID, h.translateIn(h.userLocale(ctx, participant.UserID), "dm.organizer_accepted", map[string]any{
+			"EventTitle": event.Title,
+		}))
+		grantPrivateChannelAccess(s, event.PrivateChannelID, participant.UserID)
 	}
 
-	sendDM(s, participant.UserID, h.translate("dm.organizer_accepted", map[string]any{
-		"EventTitle": event.Title,
-	}))
-	grantPrivateChannelAccess(s, event.PrivateChannelID, participant.UserID)
-
-	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
-
 	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseChannelMessageWithSource,
 		Data: &discordgo.InteractionResponseData{
-			Content: h.translate("success.organizer_accepted", map[string]any{"Username": participant.Username}),
+			Content: h.translateFor(i, "success.organizer_accepted", map[string]any{"Username": participant.Username}),
 			Flags:   discordgo.MessageFlagsEphemeral,
 		},
 	})
@@ -386,35 +358,23 @@ func (h *Handler) HandleOrganizerRefuse(s *discordgo.Session, i *discordgo.Inter
 		return
 	}
 	userID := interactionUserID(i)
-	participant, err := h.participantUseCase.RemoveParticipant(ctx, uint(participantID), userID)
+	participant, err := h.participantUseCase.RefuseParticipant(ctx, uint(participantID), userID)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotOrganizer) {
 			s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 				Type: discordgo.InteractionResponseChannelMessageWithSource,
 				Data: &discordgo.InteractionResponseData{
-					Content: h.translate("errors.only_organizer_can_refuse_candidate", nil),
+					Content: h.translateFor(i, "errors.only_organizer_can_refuse_candidate", nil),
 					Flags:   discordgo.MessageFlagsEphemeral,
 				},
 			})
 		}
 		return
 	}
-	event, _ := h.eventUseCase.GetEventByID(ctx, participant.EventID)
-	if event != nil {
-		revokePrivateChannelAccess(s, event.PrivateChannelID, participant.UserID)
-		_ = s.MessageReactionRemove(event.ChannelID, event.MessageID, reactionJoinEmoji, participant.UserID)
-		ch, _ := s.UserChannelCreate(participant.UserID)
-		if ch != nil {
-			s.ChannelMessageSend(ch.ID, h.translate("dm.organizer_refused", map[string]any{
-				"EventTitle": event.Title,
-			}))
-		}
-		h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
-	}
 	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseChannelMessageWithSource,
 		Data: &discordgo.InteractionResponseData{
-			Content: h.translate("success.organizer_refused", map[string]any{"Username": participant.Username}),
+			Content: h.translateFor(i, "success.organizer_refused", map[string]any{"Username": participant.Username}),
 			Flags:   discordgo.MessageFlagsEphemeral,
 		},
 	})
@@ -441,27 +401,20 @@ func (h *Handler) HandleWaitlistSlotAccept(s *discordgo.Session, i *discordgo.In
 		return
 	}
 	userID := interactionUserID(i)
-	if event.CreatorID != userID {
-		respondEphemeral(s, i.Interaction, h.translate("errors.only_organizer_can_accept", nil))
+	if !h.eventUseCase.CanManageEvent(event, userID) {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.only_organizer_can_accept", nil))
 		return
 	}
 	if participant.Status != domain.StatusWaitlist {
-		respondEphemeral(s, i.Interaction, h.translate("errors.participant_not_waitlist", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.participant_not_waitlist", nil))
 		return
 	}
 	promoted, _, err := h.participantUseCase.PromoteParticipant(ctx, participant.ID, userID)
 	if err != nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.finalize_generic", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.finalize_generic", nil))
 		return
 	}
-	sendDM(s, promoted.UserID, h.translate("dm.waitlist.promoted_auto", map[string]any{
-		"EventTitle": event.Title,
-	}))
-	if shouldGrantPrivateChannelOnPromote(event, time.Now()) {
-		grantPrivateChannelAccess(s, event.PrivateChannelID, promoted.UserID)
-	}
-	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
-	respondEphemeral(s, i.Interaction, h.translate("success.participant_promoted", map[string]any{
+	respondEphemeral(s, i.Interaction, h.translateFor(i, "success.participant_promoted", map[string]any{
 		"Username": promoted.Username,
 	}))
 }
@@ -472,7 +425,7 @@ func (h *Handler) HandleWaitlistSlotIgnore(s *discordgo.Session, i *discordgo.In
 	if !strings.HasPrefix(customID, prefix) {
 		return
 	}
-	respondEphemeral(s, i.Interaction, h.translate("info.no_promotion_done", nil))
+	respondEphemeral(s, i.Interaction, h.translateFor(i, "info.no_promotion_done", nil))
 }
 
 func (h *Handler) processH48OrganizerDMs(s *discordgo.Session, ctx context.Context, now time.Time) {
@@ -507,3 +460,16 @@ func (h *Handler) processEditLock(s *discordgo.Session, ctx context.Context, now
 		h.updateEmbed(ctx, s, e.ChannelID, e.MessageID)
 	}
 }
+
+// processEndedEvents refreshes the post of events that just ended: the embed shows them as over
+// and the buttons are removed.
+func (h *Handler) processEndedEvents(s *discordgo.Session, ctx context.Context, now time.Time) {
+	ended, err := h.eventUseCase.FindRecentlyEndedEvents(ctx, now)
+	if err != nil {
+		log.Printf("❌ Scheduler fin de sortie: %v", err)
+		return
+	}
+	for _, e := range ended {
+		h.updateEmbed(ctx, s, e.ChannelID, e.MessageID)
+	}
+}
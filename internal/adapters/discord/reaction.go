@@ -3,7 +3,6 @@ package discord
 import (
 	"context"
 	"errors"
-	"log"
 	"time"
 
 	"servbot/internal/domain"
@@ -35,7 +34,7 @@ func (h *Handler) shouldForceWaitlistForJoin(ctx context.Context, event *entitie
 	return err == nil && len(waitlist) > 0
 }
 
-func (h *Handler) HandleReactionJoin(s *discordgo.Session, channelID, messageID, userID, username string) {
+func (h *Handler) HandleReactionJoin(s *discordgo.Session, channelID, messageID, userID, username string, roleIDs []string) {
 	ctx := context.Background()
 	event, err := h.eventUseCase.GetEventByMessageID(ctx, messageID)
 	if err != nil {
@@ -45,68 +44,39 @@ func (h *Handler) HandleReactionJoin(s *discordgo.Session, channelID, messageID,
 		_ = s.MessageReactionRemove(channelID, messageID, reactionJoinEmoji, userID)
 		return
 	}
-	now := time.Now()
-	forceWaitlist := h.shouldForceWaitlistForJoin(ctx, event, now)
-	reply, err := h.participantUseCase.JoinEvent(ctx, h.defaultLocale, event.ID, userID, username, forceWaitlist)
-	if err != nil {
-		if errors.Is(err, domain.ErrParticipantExists) {
-			sendDM(s, userID, reply)
-		}
+	reply, err := h.joinEvent(ctx, event, userID, username, roleIDs)
+	if errors.Is(err, domain.ErrRoleNotAllowed) {
+		_ = s.MessageReactionRemove(channelID, messageID, reactionJoinEmoji, userID)
+		sendDM(s, userID, h.roleRequiredMessage(s, h.userLocale(ctx, userID), event))
 		return
 	}
-	h.updateEmbed(ctx, s, channelID, messageID)
-
-	if event.IsFinalized() {
-		p, _ := h.participantUseCase.GetParticipantByEventIDAndUserID(ctx, event.ID, userID)
-		if p != nil && p.Status == domain.StatusConfirmed {
-			grantPrivateChannelAccess(s, event.PrivateChannelID, userID)
-		}
-	}
-
-	if isCasA(event.ScheduledAt, now) {
-		eventFull, _ := h.eventUseCase.GetEventByID(ctx, event.ID)
-		if eventFull != nil {
-			confirmedCount, _ := h.eventUseCase.GetConfirmedParticipants(ctx, event.ID)
-			isComplet := eventFull.MaxSlots > 0 && len(confirmedCount) >= eventFull.MaxSlots
-			if isComplet && eventFull.OrganizerValidationDMSentAt.IsZero() {
-				evWP := eventToEventWithParticipants(eventFull)
-				if err := h.sendOrganizerValidationDM(s, evWP); err != nil {
-					log.Printf("❌ Envoi MP validation organisateur (Cas A complet): %v", err)
-				} else {
-					_ = h.eventUseCase.MarkOrganizerValidationDMSent(ctx, event.ID)
-				}
-			}
+	if err != nil {
+		if errors.Is(err, domain.ErrEventCancelled) {
+			_ = s.MessageReactionRemove(channelID, messageID, reactionJoinEmoji, userID)
 		}
-	} else if isCasB(event.ScheduledAt, now) {
-		participant, _ := h.participantUseCase.GetParticipantByEventIDAndUserID(ctx, event.ID, userID)
-		if participant != nil && participant.Status == domain.StatusConfirmed {
-			if err := h.sendOrganizerAcceptRefuseDM(s, event.Title, event.CreatorID, channelID, messageID, participant); err != nil {
-				log.Printf("❌ Envoi MP Accepter/Refuser organisateur (Cas B): %v", err)
-			}
+		if errors.Is(err, domain.ErrParticipantExists) || errors.Is(err, domain.ErrEventCancelled) {
+			sendDM(s, userID, reply)
 		}
+		return
 	}
-
 	sendDM(s, userID, reply)
 }
 
-func (h *Handler) promoteNextFromWaitlist(s *discordgo.Session, ctx context.Context, event *entities.Event) {
-	luckyWinner, err := h.participantUseCase.GetNextWaitlistParticipant(ctx, event.ID)
-	if err != nil {
-		return
-	}
-	msg := h.translate("dm.waitlist.promoted_auto", map[string]any{"EventTitle": event.Title})
-	sendDM(s, luckyWinner.UserID, msg)
-	if shouldGrantPrivateChannelOnPromote(event, time.Now()) {
-		grantPrivateChannelAccess(s, event.PrivateChannelID, luckyWinner.UserID)
-	}
+// joinEvent registers userID through one of the join entry points (✅ reaction, "Interested" on
+// the calendar event, /mes-sorties). roleIDs are the member's guild roles, checked against the
+// event's allowed and priority roles. It returns the reply to send to the user; the embed and
+// the organizer are handled by the ParticipantJoined subscriber.
+func (h *Handler) joinEvent(ctx context.Context, event *entities.Event, userID, username string, roleIDs []string) (string, error) {
+	forceWaitlist := h.shouldForceWaitlistForJoin(ctx, event, time.Now())
+	return h.participantUseCase.JoinEvent(ctx, h.userLocale(ctx, userID), event.ID, userID, username, roleIDs, forceWaitlist)
 }
 
-// onSlotFreed is called when a confirmed participant leaves or is removed.
-// Auto: promote next waitlist. Manual + Cas A: no promo. Manual + Cas B: DM orga Accept/Ignore.
-func (h *Handler) onSlotFreed(s *discordgo.Session, ctx context.Context, event *entities.Event) {
-	if event.WaitlistAuto {
-		h.promoteNextFromWaitlist(s, ctx, event)
-	}
+// roleRequiredMessage explains to a member that the event is reserved to roles they lack.
+func (h *Handler) roleRequiredMessage(s *discordgo.Session, locale string, event *entities.Event) string {
+	return h.translateIn(locale, "dm.join.role_required", map[string]any{
+		"EventTitle": event.Title,
+		"Roles":      roleNames(s, event.GuildID, event.AllowedRoleIDs),
+	})
 }
 
 func (h *Handler) HandleReactionLeave(s *discordgo.Session, channelID, messageID, userID string) {
@@ -115,18 +85,14 @@ func (h *Handler) HandleReactionLeave(s *discordgo.Session, channelID, messageID
 	if err != nil {
 		return
 	}
-	if userID == event.CreatorID {
-		return
-	}
-	wasConfirmed, err := h.participantUseCase.LeaveEvent(ctx, event.ID, userID)
-	if err != nil {
+	if userID == event.CreatorID || event.IsCancelled() {
 		return
 	}
-	revokePrivateChannelAccess(s, event.PrivateChannelID, userID)
-	msg := h.translate("dm.leave.confirmed", nil)
-	if wasConfirmed {
-		h.onSlotFreed(s, ctx, event)
-	}
-	h.updateEmbed(ctx, s, channelID, messageID)
-	sendDM(s, userID, msg)
+	h.leaveEvent(ctx, event, userID)
+}
+
+// leaveEvent unregisters userID. It returns false when the user was not registered.
+func (h *Handler) leaveEvent(ctx context.Context, event *entities.Event, userID string) bool {
+	_, err := h.participantUseCase.LeaveEvent(ctx, event.ID, userID)
+	return err == nil
 }
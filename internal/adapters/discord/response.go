@@ -1,6 +1,11 @@
 package discord
 
-import "github.com/bwmarrin/discordgo"
+import (
+	"context"
+	"log"
+
+	"github.com/bwmarrin/discordgo"
+)
 
 // Nick > GlobalName > Username
 func resolveDisplayName(member *discordgo.Member) string {
@@ -26,15 +31,23 @@ func respondEphemeral(s *discordgo.Session, i *discordgo.Interaction, content st
 	})
 }
 
-// currentLocale returns the effective locale for this handler (bot-wide for now).
-func (h *Handler) currentLocale() string {
-	if h == nil {
-		return ""
-	}
-	return h.defaultLocale
+// translateFor resolves a message key in the locale of the member's Discord client.
+// Unsupported locales fall back to the default one in the translator.
+func (h *Handler) translateFor(i *discordgo.InteractionCreate, key string, data map[string]any) string {
+	return h.translator.T(string(i.Locale), key, data)
 }
 
-// translate resolves a message key using the handler's current locale.
-func (h *Handler) translate(key string, data map[string]any) string {
-	return h.translator.T(h.currentLocale(), key, data)
+// translateIn resolves a message key in locale, e.g. the one returned by userLocale.
+func (h *Handler) translateIn(locale, key string, data map[string]any) string {
+	return h.translator.T(locale, key, data)
+}
+
+// userLocale returns the locale chosen with /langue for the DMs of userID, or "" for the default one.
+func (h *Handler) userLocale(ctx context.Context, userID string) string {
+	prefs, err := h.userPrefsUseCase.GetUserPreferences(ctx, userID)
+	if err != nil {
+		log.Printf("❌ Préférences de l'utilisateur %s: %v", userID, err)
+		return ""
+	}
+	return prefs.Locale
 }
@@ -0,0 +1,162 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// scheduledEventDescriptionMax is the limit Discord puts on a scheduled event description.
+const scheduledEventDescriptionMax = 1000
+
+func (h *Handler) scheduledEventParams(s *discordgo.Session, event *entities.Event) *discordgo.GuildScheduledEventParams {
+	startTime := event.ScheduledAt
+	endTime := event.EndTime()
+
+	location := event.Location
+	if location == "" {
+		location = h.translateIn(h.eventLocale(context.Background(), s, event), "ui.calendar_location_placeholder", nil)
+	}
+	description := event.Description
+	if r := []rune(description); len(r) > scheduledEventDescriptionMax {
+		description = string(r[:scheduledEventDescriptionMax-1]) + "…"
+	}
+	return &discordgo.GuildScheduledEventParams{
+		Name:               event.Title,
+		Description:        description,
+		ScheduledStartTime: &startTime,
+		ScheduledEndTime:   &endTime,
+		PrivacyLevel:       discordgo.GuildScheduledEventPrivacyLevelGuildOnly,
+		EntityType:         discordgo.GuildScheduledEventEntityTypeExternal,
+		EntityMetadata: &discordgo.GuildScheduledEventEntityMetadata{
+			Location: location,
+		},
+	}
+}
+
+// createDiscordScheduledEvent adds the finalized event to the guild calendar and keeps its ID.
+func (h *Handler) createDiscordScheduledEvent(s *discordgo.Session, event *entities.Event) {
+	guildID := eventGuildID(s, event.GuildID, event.ChannelID)
+	if guildID == "" {
+		return
+	}
+	se, err := s.GuildScheduledEventCreate(guildID, h.scheduledEventParams(s, event))
+	if err != nil {
+		log.Printf("❌ Création événement calendrier Discord (event %d): %v", event.ID, err)
+		return
+	}
+	if err := h.eventUseCase.SetScheduledEventID(context.Background(), event.ID, se.ID); err != nil {
+		log.Printf("❌ Enregistrement de l'événement calendrier Discord (event %d): %v", event.ID, err)
+		return
+	}
+	event.ScheduledEventID = se.ID
+}
+
+// syncDiscordScheduledEvent applies the event's title, description, dates and location to its
+// calendar event. Once started, Discord no longer accepts a new start time, so only upcoming
+// events are synced.
+func (h *Handler) syncDiscordScheduledEvent(s *discordgo.Session, event *entities.Event) {
+	if event.ScheduledEventID == "" || event.ScheduledAt.IsZero() || event.HasStarted() {
+		return
+	}
+	if event.IsCancelled() {
+		h.deleteDiscordScheduledEvent(s, event)
+		return
+	}
+	guildID := eventGuildID(s, event.GuildID, event.ChannelID)
+	if guildID == "" {
+		return
+	}
+	if _, err := s.GuildScheduledEventEdit(guildID, event.ScheduledEventID, h.scheduledEventParams(s, event)); err != nil {
+		log.Printf("❌ Mise à jour événement calendrier Discord (event %d): %v", event.ID, err)
+	}
+}
+
+// deleteDiscordScheduledEvent removes the calendar event created at finalization, if any.
+func (h *Handler) deleteDiscordScheduledEvent(s *discordgo.Session, event *entities.Event) {
+	if event.ScheduledEventID == "" {
+		return
+	}
+	guildID := eventGuildID(s, event.GuildID, event.ChannelID)
+	if guildID == "" {
+		return
+	}
+	if err := s.GuildScheduledEventDelete(guildID, event.ScheduledEventID); err != nil {
+		log.Printf("❌ Suppression événement calendrier Discord (event %d): %v", event.ID, err)
+	}
+	if err := h.eventUseCase.SetScheduledEventID(context.Background(), event.ID, ""); err != nil {
+		log.Printf("❌ Oubli de l'événement calendrier Discord (event %d): %v", event.ID, err)
+	}
+	event.ScheduledEventID = ""
+}
+
+// HandleScheduledEventUpdate reflects a calendar event started or ended from Discord on its sortie.
+func (h *Handler) HandleScheduledEventUpdate(s *discordgo.Session, se *discordgo.GuildScheduledEvent) {
+	ctx := context.Background()
+	switch se.Status {
+	case discordgo.GuildScheduledEventStatusActive:
+		_, _ = h.eventUseCase.MarkScheduledEventStarted(ctx, se.ID, time.Now())
+	case discordgo.GuildScheduledEventStatusCompleted:
+		_, _ = h.eventUseCase.MarkScheduledEventEnded(ctx, se.ID, time.Now())
+	case discordgo.GuildScheduledEventStatusCanceled:
+		h.HandleScheduledEventDelete(s, se)
+	}
+}
+
+// HandleScheduledEventDelete forgets a calendar event removed from Discord.
+func (h *Handler) HandleScheduledEventDelete(s *discordgo.Session, se *discordgo.GuildScheduledEvent) {
+	ctx := context.Background()
+	event, err := h.eventUseCase.GetEventByScheduledEventID(ctx, se.ID)
+	if err != nil {
+		return
+	}
+	if err := h.eventUseCase.SetScheduledEventID(ctx, event.ID, ""); err != nil {
+		log.Printf("❌ Oubli de l'événement calendrier Discord (event %d): %v", event.ID, err)
+	}
+}
+
+// HandleScheduledEventUserAdd registers a member who clicked "Interested" on the calendar event
+// of a sortie, with the same rules as the ✅ reaction. Members already registered are left as is.
+func (h *Handler) HandleScheduledEventUserAdd(s *discordgo.Session, guildID, scheduledEventID, userID string) {
+	ctx := context.Background()
+	event, err := h.eventUseCase.GetEventByScheduledEventID(ctx, scheduledEventID)
+	if err != nil || userID == event.CreatorID || event.IsCancelled() {
+		return
+	}
+	username := userID
+	var roleIDs []string
+	if member, err := s.GuildMember(guildID, userID); err == nil && member != nil {
+		if name := resolveDisplayName(member); name != "" {
+			username = name
+		}
+		roleIDs = member.Roles
+	}
+	reply, err := h.joinEvent(ctx, event, userID, username, roleIDs)
+	if errors.Is(err, domain.ErrRoleNotAllowed) {
+		sendDM(s, userID, h.roleRequiredMessage(s, h.userLocale(ctx, userID), event))
+		return
+	}
+	if err != nil {
+		return
+	}
+	sendDM(s, userID, reply)
+}
+
+// HandleScheduledEventUserRemove unregisters a member who is no longer interested in the calendar
+// event; their ✅ reaction, if any, is removed so the forum post stays consistent.
+func (h *Handler) HandleScheduledEventUserRemove(s *discordgo.Session, scheduledEventID, userID string) {
+	ctx := context.Background()
+	event, err := h.eventUseCase.GetEventByScheduledEventID(ctx, scheduledEventID)
+	if err != nil || userID == event.CreatorID || event.IsCancelled() {
+		return
+	}
+	if h.leaveEvent(ctx, event, userID) {
+		_ = s.MessageReactionRemove(event.ChannelID, event.MessageID, reactionJoinEmoji, userID)
+	}
+}
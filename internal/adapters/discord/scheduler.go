@@ -7,7 +7,8 @@ import (
 	"github.com/bwmarrin/discordgo"
 )
 
-// RunScheduledTasks runs periodic tasks every 10 minutes: H-48 organizer DMs and edit-lock embed refresh.
+// RunScheduledTasks runs periodic tasks every 10 minutes: H-48 organizer DMs, edit-lock and end-of-event
+// embed refresh, publication of the next occurrence of recurring events and expiry of waitlist slot offers.
 func (h *Handler) RunScheduledTasks(s *discordgo.Session) {
 	ticker := time.NewTicker(10 * time.Minute)
 	defer ticker.Stop()
@@ -16,5 +17,8 @@ func (h *Handler) RunScheduledTasks(s *discordgo.Session) {
 		now := time.Now()
 		h.processH48OrganizerDMs(s, ctx, now)
 		h.processEditLock(s, ctx, now)
+		h.processEndedEvents(s, ctx, now)
+		h.processRecurringEvents(s, ctx, now)
+		h.processExpiredSlotOffers(ctx, now)
 	}
 }
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-	"time"
 
 	"servbot/internal/domain"
 	"servbot/internal/domain/entities"
@@ -68,41 +67,42 @@ func (h *Handler) HandleManageWaitlist(s *discordgo.Session, i *discordgo.Intera
 	ctx := context.Background()
 	event, err := h.eventUseCase.GetEventByMessageID(ctx, i.Message.ID)
 	if err != nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.event_not_found", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_not_found", nil))
 		return
 	}
-	if i.Member.User.ID != event.CreatorID {
-		respondEphemeral(s, i.Interaction, h.translate("errors.only_organizer_can_manage_waitlist", nil))
+	if !h.eventUseCase.CanManageEvent(event, interactionUserID(i)) {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.only_organizer_can_manage_waitlist", nil))
 		return
 	}
 
 	waitlistParticipants, err := h.eventUseCase.GetWaitlistParticipants(ctx, event.ID)
 	if err != nil || len(waitlistParticipants) == 0 {
-		respondEphemeral(s, i.Interaction, h.translate("info.waitlist.empty", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "info.waitlist.empty", nil))
 		return
 	}
 
-	content := h.translate("ui.waitlist_manage_intro", nil)
+	content := h.translateFor(i, "ui.waitlist_manage_intro", nil)
 
 	options := make([]discordgo.SelectMenuOption, 0, len(waitlistParticipants))
 	for _, p := range waitlistParticipants {
 		if p.ID == 0 {
 			continue
 		}
-		display, username := displayAndUsername(s, h.guildID, p.UserID, p.Username)
+		display, username := displayAndUsername(s, i.GuildID, p.UserID, p.Username)
 		label := truncateLabel(waitlistOptionLabel(display, username), maxSelectLabelLen)
 		options = append(options, discordgo.SelectMenuOption{
 			Label:       label,
 			Value:       fmt.Sprintf("promote_%d", p.ID),
-			Description: h.translate("ui.waitlist_option_promote", nil),
+			Description: h.translateFor(i, "ui.waitlist_option_promote", nil),
 		})
 	}
 
 	if len(options) == 0 {
-		respondEphemeral(s, i.Interaction, h.translate("info.waitlist.empty", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "info.waitlist.empty", nil))
 		return
 	}
 
+	locale := string(i.Locale)
 	var components []discordgo.MessageComponent
 	for i := 0; i < maxSelectMenus && i*maxSelectOptions < len(options); i++ {
 		start := i * maxSelectOptions
@@ -112,7 +112,7 @@ func (h *Handler) HandleManageWaitlist(s *discordgo.Session, i *discordgo.Intera
 			Components: []discordgo.MessageComponent{
 				discordgo.SelectMenu{
 					CustomID:    fmt.Sprintf("select_promote_%d", i),
-					Placeholder: h.translate("ui.waitlist_placeholder_range", map[string]any{"Start": start + 1, "End": end}),
+					Placeholder: h.translateIn(locale, "ui.waitlist_placeholder_range", map[string]any{"Start": start + 1, "End": end}),
 					Options:     chunk,
 				},
 			},
@@ -120,7 +120,7 @@ func (h *Handler) HandleManageWaitlist(s *discordgo.Session, i *discordgo.Intera
 	}
 
 	if len(options) > maxSelectOptions*maxSelectMenus {
-		content += h.translate("ui.waitlist_manage_truncated", map[string]any{"Max": maxSelectOptions * maxSelectMenus})
+		content += h.translateFor(i, "ui.waitlist_manage_truncated", map[string]any{"Max": maxSelectOptions * maxSelectMenus})
 	}
 
 	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
@@ -137,12 +137,12 @@ func (h *Handler) HandlePromote(s *discordgo.Session, i *discordgo.InteractionCr
 	ctx := context.Background()
 	data := i.MessageComponentData()
 	if len(data.Values) == 0 {
-		respondEphemeral(s, i.Interaction, h.translate("errors.no_selection", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.no_selection", nil))
 		return
 	}
 	participantID, ok := parseParticipantID(data.Values[0], "promote_")
 	if !ok {
-		respondEphemeral(s, i.Interaction, h.translate("errors.invalid_selection", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.invalid_selection", nil))
 		return
 	}
 
@@ -159,22 +159,13 @@ func (h *Handler) HandlePromote(s *discordgo.Session, i *discordgo.InteractionCr
 		default:
 			key = "errors.finalize_generic"
 		}
-		respondEphemeral(s, i.Interaction, h.translate(key, nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, key, nil))
 		return
 	}
 
-	event, _ := h.eventUseCase.GetEventByID(ctx, participant.EventID)
-	if event != nil {
-		sendDM(s, participant.UserID, h.translate("dm.waitlist.promoted_by_organizer", map[string]any{"EventTitle": event.Title}))
-		if shouldGrantPrivateChannelOnPromote(event, time.Now()) {
-			grantPrivateChannelAccess(s, event.PrivateChannelID, participant.UserID)
-		}
-		h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
-	}
-
-	msg := h.translate("success.participant_promoted", map[string]any{"Username": participant.Username})
+	msg := h.translateFor(i, "success.participant_promoted", map[string]any{"Username": participant.Username})
 	if quotaIncreased {
-		msg = h.translate("success.participant_promoted_with_quota", map[string]any{"Username": participant.Username})
+		msg = h.translateFor(i, "success.participant_promoted_with_quota", map[string]any{"Username": participant.Username})
 	}
 	respondEphemeral(s, i.Interaction, msg)
 }
@@ -184,7 +175,7 @@ func (h *Handler) HandlePromote(s *discordgo.Session, i *discordgo.InteractionCr
 func (h *Handler) respondRemoveSelect(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, event *entities.Event) {
 	confirmed, err := h.eventUseCase.GetConfirmedParticipants(ctx, event.ID)
 	if err != nil || len(confirmed) == 0 {
-		respondEphemeral(s, i.Interaction, h.translate("info.no_confirmed_to_remove", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "info.no_confirmed_to_remove", nil))
 		return
 	}
 
@@ -193,31 +184,31 @@ func (h *Handler) respondRemoveSelect(ctx context.Context, s *discordgo.Session,
 		if p.UserID == event.CreatorID {
 			continue
 		}
-		display, username := displayAndUsername(s, h.guildID, p.UserID, p.Username)
+		display, username := displayAndUsername(s, i.GuildID, p.UserID, p.Username)
 		label := truncateLabel(waitlistOptionLabel(display, username), maxSelectLabelLen)
 		options = append(options, discordgo.SelectMenuOption{
 			Label:       label,
 			Value:       fmt.Sprintf("remove_%d", p.ID),
-			Description: h.translate("ui.remove_option_description", nil),
+			Description: h.translateFor(i, "ui.remove_option_description", nil),
 		})
 	}
 
 	if len(options) == 0 {
-		respondEphemeral(s, i.Interaction, h.translate("info.no_confirmed_to_remove", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "info.no_confirmed_to_remove", nil))
 		return
 	}
 
 	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseChannelMessageWithSource,
 		Data: &discordgo.InteractionResponseData{
-			Content: h.translate("ui.remove_select_intro", nil),
+			Content: h.translateFor(i, "ui.remove_select_intro", nil),
 			Flags:   discordgo.MessageFlagsEphemeral,
 			Components: []discordgo.MessageComponent{
 				discordgo.ActionsRow{
 					Components: []discordgo.MessageComponent{
 						discordgo.SelectMenu{
 							CustomID:    "select_remove_user",
-							Placeholder: h.translate("ui.remove_placeholder", nil),
+							Placeholder: h.translateFor(i, "ui.remove_placeholder", nil),
 							Options:     options,
 							MaxValues:   len(options),
 						},
@@ -233,11 +224,11 @@ func (h *Handler) HandleRemoveParticipant(s *discordgo.Session, i *discordgo.Int
 	ctx := context.Background()
 	event, err := h.eventUseCase.GetEventByMessageID(ctx, i.Message.ID)
 	if err != nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.event_not_found", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_not_found", nil))
 		return
 	}
-	if i.Member.User.ID != event.CreatorID {
-		respondEphemeral(s, i.Interaction, h.translate("errors.only_organizer_can_remove", nil))
+	if !h.eventUseCase.CanManageEvent(event, interactionUserID(i)) {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.only_organizer_can_remove", nil))
 		return
 	}
 
@@ -250,12 +241,12 @@ func (h *Handler) HandleRemoveCommand(s *discordgo.Session, i *discordgo.Interac
 
 	event, err := h.eventUseCase.GetEventByPrivateChannelID(ctx, i.ChannelID)
 	if err != nil {
-		respondEphemeral(s, i.Interaction, h.translate("errors.remove_command_wrong_channel", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.remove_command_wrong_channel", nil))
 		return
 	}
 
-	if i.Member.User.ID != event.CreatorID {
-		respondEphemeral(s, i.Interaction, h.translate("errors.only_organizer_can_remove", nil))
+	if !h.eventUseCase.CanManageEvent(event, interactionUserID(i)) {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.only_organizer_can_remove", nil))
 		return
 	}
 
@@ -270,7 +261,6 @@ func (h *Handler) HandleRemoveUserSelect(s *discordgo.Session, i *discordgo.Inte
 		return
 	}
 
-	var event *entities.Event
 	removed := make([]string, 0, len(data.Values))
 
 	for _, val := range data.Values {
@@ -279,48 +269,21 @@ func (h *Handler) HandleRemoveUserSelect(s *discordgo.Session, i *discordgo.Inte
 			continue
 		}
 
-		participant, err := h.participantUseCase.GetParticipantByID(ctx, pID)
+		participant, err := h.participantUseCase.RemoveParticipant(ctx, pID, interactionUserID(i))
 		if err != nil {
 			continue
 		}
-
-		// Resolve event once from the first valid participant.
-		if event == nil {
-			event, err = h.eventUseCase.GetEventByID(ctx, participant.EventID)
-			if err != nil {
-				respondEphemeral(s, i.Interaction, h.translate("errors.event_not_found", nil))
-				return
-			}
-		}
-
-		wasConfirmed, err := h.participantUseCase.LeaveEvent(ctx, event.ID, participant.UserID)
-		if err != nil {
-			continue
-		}
-
-		_ = s.MessageReactionRemove(event.ChannelID, event.MessageID, reactionJoinEmoji, participant.UserID)
-		revokePrivateChannelAccess(s, event.PrivateChannelID, participant.UserID)
-
-		if wasConfirmed {
-			h.onSlotFreed(s, ctx, event)
-		}
-
-		sendDM(s, participant.UserID, h.translate("dm.removed_by_organizer", map[string]any{"EventTitle": event.Title}))
 		removed = append(removed, fmt.Sprintf("<@%s>", participant.UserID))
 	}
 
-	if event != nil {
-		h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
-	}
-
 	if len(removed) == 0 {
-		respondEphemeral(s, i.Interaction, h.translate("errors.no_participant_removed", nil))
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.no_participant_removed", nil))
 		return
 	}
 
-	msg := h.translate("success.participant_removed_single", map[string]any{"Mention": removed[0]})
+	msg := h.translateFor(i, "success.participant_removed_single", map[string]any{"Mention": removed[0]})
 	if len(removed) > 1 {
-		msg = h.translate("success.participant_removed_many", map[string]any{
+		msg = h.translateFor(i, "success.participant_removed_many", map[string]any{
 			"Count":    len(removed),
 			"Mentions": strings.Join(removed, ", "),
 		})
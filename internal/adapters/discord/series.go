@@ -0,0 +1,228 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+	pkgdiscord "servbot/pkg/discord"
+	"servbot/pkg/tz"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func (h *Handler) recurrenceLabel(i *discordgo.InteractionCreate, recurrence string) string {
+	if recurrence == domain.RecurrenceMonthly {
+		return h.translateFor(i, "ui.recurrence_monthly", nil)
+	}
+	return h.translateFor(i, "ui.recurrence_weekly", nil)
+}
+
+// parseEventIDButton extracts the event ID of a "<prefix><eventID>" button.
+func parseEventIDButton(i *discordgo.InteractionCreate, prefix string) (uint, bool) {
+	idStr, ok := strings.CutPrefix(i.MessageComponentData().CustomID, prefix)
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondSeriesEditScope asks the organizer whether an edit applies to one occurrence or to the whole series.
+func (h *Handler) respondSeriesEditScope(s *discordgo.Session, i *discordgo.InteractionCreate, event *entities.Event) {
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: h.translateFor(i, "ui.series_edit_scope_intro", nil),
+			Flags:   discordgo.MessageFlagsEphemeral,
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+					discordgo.Button{Label: h.translateFor(i, "ui.btn_series_edit_occurrence", nil), Style: discordgo.SecondaryButton, CustomID: fmt.Sprintf("btn_series_edit_occurrence_%d", event.ID)},
+					discordgo.Button{Label: h.translateFor(i, "ui.btn_series_edit_all", nil), Style: discordgo.PrimaryButton, CustomID: fmt.Sprintf("btn_series_edit_all_%d", event.ID)},
+				}},
+			},
+		},
+	})
+}
+
+// HandleSeriesEditScope opens the edit modal for the scope picked in respondSeriesEditScope.
+func (h *Handler) HandleSeriesEditScope(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	customID, title := "edit_event_modal_%d", h.translateFor(i, "ui.modal_edit_event_title", nil)
+	eventID, ok := parseEventIDButton(i, "btn_series_edit_occurrence_")
+	if !ok {
+		eventID, ok = parseEventIDButton(i, "btn_series_edit_all_")
+		customID, title = "edit_series_modal_%d", h.translateFor(i, "ui.modal_edit_series_title", nil)
+	}
+	if !ok {
+		return
+	}
+
+	ctx := context.Background()
+	event, err := h.eventUseCase.GetEventByID(ctx, eventID)
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_not_found", nil))
+		return
+	}
+	if !h.eventUseCase.CanManageEvent(event, interactionUserID(i)) {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.only_organizer_can_edit", nil))
+		return
+	}
+	if event.IsEditLocked() {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_locked", nil))
+		return
+	}
+	h.respondEditEventModal(s, i, event, fmt.Sprintf(customID, event.ID), title)
+}
+
+// HandleManageSeries is triggered by the embed "Série" button and lists the series actions.
+func (h *Handler) HandleManageSeries(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	event, err := h.eventUseCase.GetEventByMessageID(ctx, i.Message.ID)
+	if err != nil || event.SeriesID == 0 {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_not_found", nil))
+		return
+	}
+	if !h.eventUseCase.CanManageEvent(event, interactionUserID(i)) {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.only_organizer_can_manage_series", nil))
+		return
+	}
+	series, err := h.eventUseCase.GetSeriesByID(ctx, event.SeriesID)
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.series_not_found", nil))
+		return
+	}
+
+	buttons := []discordgo.MessageComponent{
+		discordgo.Button{Label: h.translateFor(i, "ui.btn_series_skip", nil), Style: discordgo.SecondaryButton, CustomID: fmt.Sprintf("btn_series_skip_%d", event.ID)},
+	}
+	content := h.translateFor(i, "ui.series_manage_intro", map[string]any{"Recurrence": h.recurrenceLabel(i, series.Recurrence)})
+	if series.IsCancelled() {
+		content = h.translateFor(i, "ui.series_manage_intro_cancelled", nil)
+	} else {
+		buttons = append(buttons, discordgo.Button{Label: h.translateFor(i, "ui.btn_series_stop", nil), Style: discordgo.DangerButton, CustomID: fmt.Sprintf("btn_series_stop_%d", event.ID)})
+	}
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Flags:      discordgo.MessageFlagsEphemeral,
+			Components: []discordgo.MessageComponent{discordgo.ActionsRow{Components: buttons}},
+		},
+	})
+}
+
+// HandleSeriesSkip cancels a single occurrence through the regular cancellation flow.
+// The series goes on with the next date.
+func (h *Handler) HandleSeriesSkip(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	eventID, ok := parseEventIDButton(i, "btn_series_skip_")
+	if !ok {
+		return
+	}
+	event, err := h.eventUseCase.GetEventByID(context.Background(), eventID)
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_not_found", nil))
+		return
+	}
+	if h.checkCancellable(s, i, event) {
+		h.respondCancelEventModal(s, i, event)
+	}
+}
+
+// HandleSeriesStop stops the series: no further occurrence is published, the current one is kept.
+func (h *Handler) HandleSeriesStop(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	eventID, ok := parseEventIDButton(i, "btn_series_stop_")
+	if !ok {
+		return
+	}
+	ctx := context.Background()
+	event, err := h.eventUseCase.GetEventByID(ctx, eventID)
+	if err != nil {
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.event_not_found", nil))
+		return
+	}
+	if _, err := h.eventUseCase.CancelSeries(ctx, event.ID, interactionUserID(i)); err != nil {
+		key := "errors.generic"
+		switch {
+		case errors.Is(err, domain.ErrNotOrganizer):
+			key = "errors.only_organizer_can_manage_series"
+		case errors.Is(err, domain.ErrEventNotFound), errors.Is(err, domain.ErrSeriesNotFound), errors.Is(err, domain.ErrSeriesCancelled):
+			key = "errors." + domain.Code(err)
+		}
+		respondEphemeral(s, i.Interaction, h.translateFor(i, key, nil))
+		return
+	}
+	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
+	respondEphemeral(s, i.Interaction, h.translateFor(i, "success.series_cancelled", nil))
+}
+
+// publishNextOccurrence publishes and saves the next occurrence of series. When the save fails,
+// the post and channel are deleted again so that the next tick starts from a clean slate.
+func (h *Handler) publishNextOccurrence(s *discordgo.Session, ctx context.Context, series *entities.Series, now time.Time) {
+	guildID := series.GuildID
+	if guildID == "" {
+		log.Printf("❌ Série %d: serveur introuvable", series.ID)
+		return
+	}
+	settings := h.guildSettings(ctx, guildID)
+	event := series.NextOccurrence(now, tz.Location(series.Timezone))
+
+	displayName, avatarURL := series.CreatorID, ""
+	if member, err := s.GuildMember(guildID, series.CreatorID); err == nil && member != nil && member.User != nil {
+		if name := resolveDisplayName(member); name != "" {
+			displayName = name
+		}
+		avatarURL = member.User.AvatarURL("256")
+	}
+	embed := pkgdiscord.BuildNewEventEmbed(h.translator, settings.Locale, series.CreatorID, event.Description, event.Location, event.ScheduledAt, event.EndsAt, event.MaxSlots, event.MaxGuests, displayName, avatarURL)
+
+	if err := h.publishEvent(s, settings, event, embed); err != nil {
+		log.Printf("❌ Publication de l'occurrence suivante (série %d): %v", series.ID, err)
+		return
+	}
+	if err := h.eventUseCase.CreateNextOccurrence(ctx, series, event, displayName); err != nil {
+		if !errors.Is(err, domain.ErrSeriesAlreadyAdvanced) {
+			log.Printf("❌ Sauvegarde de l'occurrence suivante (série %d): %v", series.ID, err)
+		}
+		unpublishEvent(s, event)
+		return
+	}
+	h.updateEmbed(ctx, s, event.ChannelID, event.MessageID)
+	_ = s.MessageReactionAdd(event.ChannelID, event.MessageID, reactionJoinEmoji)
+}
+
+// processRecurringEvents publishes the next occurrence of every series whose current one is finalized or has started.
+func (h *Handler) processRecurringEvents(s *discordgo.Session, ctx context.Context, now time.Time) {
+	due, err := h.eventUseCase.SeriesNeedingNextOccurrence(ctx, now)
+	if err != nil {
+		log.Printf("❌ Scheduler séries: %v", err)
+		return
+	}
+	for i := range due {
+		h.publishNextOccurrence(s, ctx, &due[i], now)
+	}
+}
+
+// processSeries publishes the next occurrence of seriesID when it is due, as processRecurringEvents
+// does for every series.
+func (h *Handler) processSeries(s *discordgo.Session, ctx context.Context, seriesID uint, now time.Time) {
+	due, err := h.eventUseCase.SeriesNeedingNextOccurrence(ctx, now)
+	if err != nil {
+		log.Printf("❌ Série %d: %v", seriesID, err)
+		return
+	}
+	for i := range due {
+		if due[i].ID == seriesID {
+			h.publishNextOccurrence(s, ctx, &due[i], now)
+			return
+		}
+	}
+}
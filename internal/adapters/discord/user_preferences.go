@@ -0,0 +1,32 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"servbot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// HandleLocaleCommand stores the language of the member's DMs (/langue).
+func (h *Handler) HandleLocaleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	locale := ""
+	for _, opt := range i.ApplicationCommandData().Options {
+		if opt.Name == "langue" {
+			locale = opt.StringValue()
+		}
+	}
+	if err := h.userPrefsUseCase.SetUserLocale(context.Background(), interactionUserID(i), locale); err != nil {
+		key := "errors.generic"
+		if errors.Is(err, domain.ErrInvalidLocale) {
+			key = "errors." + domain.Code(err)
+		} else {
+			log.Printf("❌ Sauvegarde de la langue (user %s): %v", interactionUserID(i), err)
+		}
+		respondEphemeral(s, i.Interaction, h.translateFor(i, key, nil))
+		return
+	}
+	respondEphemeral(s, i.Interaction, h.translateIn(locale, "success.locale_updated", nil))
+}
@@ -0,0 +1,119 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+	pkgdiscord "servbot/pkg/discord"
+)
+
+// sendSlotOfferDM asks the participant a slot was offered to whether they take it before the deadline.
+func (h *Handler) sendSlotOfferDM(s *discordgo.Session, ctx context.Context, event *entities.Event, participant *entities.Participant) error {
+	ch, err := s.UserChannelCreate(participant.UserID)
+	if err != nil || ch == nil {
+		return fmt.Errorf("create participant DM channel: %w", err)
+	}
+	locale := h.userLocale(ctx, participant.UserID)
+	data := map[string]any{"EventTitle": event.Title, "Deadline": pkgdiscord.FormatTimestamp(participant.OfferExpiresAt)}
+	var content string
+	if link := messageLink(s, event.GuildID, event.ChannelID, event.MessageID); link != "" {
+		data["Link"] = link
+		content = h.translateIn(locale, "dm.waitlist.offer_link", data)
+	} else {
+		content = h.translateIn(locale, "dm.waitlist.offer", data)
+	}
+	if !event.ScheduledAt.IsZero() {
+		content += "\n" + h.translateIn(locale, "ui.dm_date_line", map[string]any{"Date": pkgdiscord.FormatTimestamp(event.ScheduledAt)})
+	}
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    h.translateIn(locale, "ui.btn_accept", nil),
+					Style:    discordgo.SuccessButton,
+					CustomID: fmt.Sprintf("btn_offer_accept_%d", participant.ID),
+				},
+				discordgo.Button{
+					Label:    h.translateIn(locale, "ui.btn_decline", nil),
+					Style:    discordgo.DangerButton,
+					CustomID: fmt.Sprintf("btn_offer_decline_%d", participant.ID),
+				},
+			},
+		},
+	}
+	_, err = s.ChannelMessageSendComplex(ch.ID, &discordgo.MessageSend{
+		Content:    content,
+		Components: components,
+	})
+	return err
+}
+
+// HandleSlotOfferAccept confirms the member on the slot offered to them.
+func (h *Handler) HandleSlotOfferAccept(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	participantID, ok := parseParticipantID(i.MessageComponentData().CustomID, "btn_offer_accept_")
+	if !ok {
+		return
+	}
+	participant, err := h.participantUseCase.AcceptSlotOffer(context.Background(), participantID, interactionUserID(i), time.Now())
+	if err != nil {
+		h.respondSlotOfferError(s, i, err)
+		return
+	}
+	h.closeSlotOffer(s, i, h.translateFor(i, "success.offer_accepted", map[string]any{"EventTitle": h.offerEventTitle(participant)}))
+}
+
+// HandleSlotOfferDecline unregisters the member; the slot is offered to the next one on the waitlist.
+func (h *Handler) HandleSlotOfferDecline(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	participantID, ok := parseParticipantID(i.MessageComponentData().CustomID, "btn_offer_decline_")
+	if !ok {
+		return
+	}
+	participant, err := h.participantUseCase.DeclineSlotOffer(context.Background(), participantID, interactionUserID(i))
+	if err != nil {
+		h.respondSlotOfferError(s, i, err)
+		return
+	}
+	h.closeSlotOffer(s, i, h.translateFor(i, "success.offer_declined", map[string]any{"EventTitle": h.offerEventTitle(participant)}))
+}
+
+func (h *Handler) offerEventTitle(participant *entities.Participant) string {
+	event, err := h.eventUseCase.GetEventByID(context.Background(), participant.EventID)
+	if err != nil {
+		return ""
+	}
+	return event.Title
+}
+
+// closeSlotOffer replaces the offer DM by content, removing its buttons.
+func (h *Handler) closeSlotOffer(s *discordgo.Session, i *discordgo.InteractionCreate, content string) {
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+}
+
+func (h *Handler) respondSlotOfferError(s *discordgo.Session, i *discordgo.InteractionCreate, err error) {
+	switch {
+	case errors.Is(err, domain.ErrOfferNotFound), errors.Is(err, domain.ErrOfferExpired):
+		h.closeSlotOffer(s, i, h.translateFor(i, "errors."+domain.Code(err), nil))
+	default:
+		log.Printf("❌ Réponse à une place proposée: %v", err)
+		respondEphemeral(s, i.Interaction, h.translateFor(i, "errors.generic", nil))
+	}
+}
+
+func (h *Handler) processExpiredSlotOffers(ctx context.Context, now time.Time) {
+	if err := h.participantUseCase.ExpireSlotOffers(ctx, now); err != nil {
+		log.Printf("❌ Scheduler places proposées: %v", err)
+	}
+}
@@ -0,0 +1,211 @@
+package httpserver
+
+import (
+	"crypto/subtle"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+)
+
+type eventJSON struct {
+	ID           uint              `json:"id"`
+	GuildID      string            `json:"guild_id"`
+	ChannelID    string            `json:"channel_id"`
+	MessageID    string            `json:"message_id"`
+	CreatorID    string            `json:"creator_id"`
+	Title        string            `json:"title"`
+	Description  string            `json:"description"`
+	Location     string            `json:"location,omitempty"`
+	MaxSlots     int               `json:"max_slots"` // 0 = unlimited
+	MaxGuests    int               `json:"max_guests"`
+	ScheduledAt  *time.Time        `json:"scheduled_at,omitempty"`
+	EndsAt       *time.Time        `json:"ends_at,omitempty"`
+	Timezone     string            `json:"timezone,omitempty"`
+	WaitlistAuto bool              `json:"waitlist_auto"`
+	SeriesID     uint              `json:"series_id,omitempty"`
+	FinalizedAt  *time.Time        `json:"finalized_at,omitempty"`
+	CancelledAt  *time.Time        `json:"cancelled_at,omitempty"`
+	CancelReason string            `json:"cancel_reason,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+	Participants []participantJSON `json:"participants,omitempty"`
+}
+
+type participantJSON struct {
+	ID       uint      `json:"id"`
+	UserID   string    `json:"user_id"`
+	Username string    `json:"username"`
+	Status   string    `json:"status"`
+	Guests   int       `json:"guests"`
+	Position int       `json:"position"` // 1-based rank within its status
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+type errorJSON struct {
+	Error string `json:"error"`
+}
+
+// requireToken rejects requests without "Authorization: Bearer <API_TOKEN>".
+func (s *Server) requireToken(next http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.apiToken)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="servbot"`)
+			writeError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+		next(w, r)
+	})
+}
+
+func (s *Server) handleListEvents(w http.ResponseWriter, r *http.Request) {
+	events, err := s.eventUC.GetEventsByGuildID(r.Context(), r.PathValue("guildID"))
+	if err != nil {
+		log.Printf("❌ API liste des sorties: %v", err)
+		writeError(w, http.StatusInternalServerError, "internal")
+		return
+	}
+	out := make([]eventJSON, len(events))
+	for i := range events {
+		out[i] = toEventJSON(&events[i])
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (s *Server) handleGetEvent(w http.ResponseWriter, r *http.Request) {
+	event, ok := s.eventFromPath(w, r)
+	if !ok {
+		return
+	}
+	roster, err := s.participantUC.GetRoster(r.Context(), event.ID)
+	if err != nil {
+		s.writeUseCaseError(w, err)
+		return
+	}
+	out := toEventJSON(event)
+	out.Participants = toParticipantsJSON(roster)
+	writeJSON(w, http.StatusOK, out)
+}
+
+// handleExportRoster returns the roster as CSV, or as JSON with ?format=json.
+func (s *Server) handleExportRoster(w http.ResponseWriter, r *http.Request) {
+	event, ok := s.eventFromPath(w, r)
+	if !ok {
+		return
+	}
+	roster, err := s.participantUC.GetRoster(r.Context(), event.ID)
+	if err != nil {
+		s.writeUseCaseError(w, err)
+		return
+	}
+	participants := toParticipantsJSON(roster)
+	if r.URL.Query().Get("format") == "json" {
+		writeJSON(w, http.StatusOK, participants)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="sortie-%d.csv"`, event.ID))
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"status", "position", "user_id", "username", "guests", "joined_at"})
+	for _, p := range participants {
+		_ = cw.Write([]string{p.Status, strconv.Itoa(p.Position), p.UserID, p.Username, strconv.Itoa(p.Guests), p.JoinedAt.UTC().Format(time.RFC3339)})
+	}
+	cw.Flush()
+}
+
+// eventFromPath loads the event of the {id} path value, writing the error response when it fails.
+func (s *Server) eventFromPath(w http.ResponseWriter, r *http.Request) (*entities.Event, bool) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		writeError(w, http.StatusNotFound, domain.Code(domain.ErrEventNotFound))
+		return nil, false
+	}
+	event, err := s.eventUC.GetEventByID(r.Context(), uint(id))
+	if err != nil {
+		writeError(w, http.StatusNotFound, domain.Code(domain.ErrEventNotFound))
+		return nil, false
+	}
+	return event, true
+}
+
+func (s *Server) writeUseCaseError(w http.ResponseWriter, err error) {
+	if errors.Is(err, domain.ErrEventNotFound) {
+		writeError(w, http.StatusNotFound, domain.Code(err))
+		return
+	}
+	log.Printf("❌ API: %v", err)
+	writeError(w, http.StatusInternalServerError, "internal")
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("⚠️ API encodage JSON: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	writeJSON(w, status, errorJSON{Error: code})
+}
+
+func toEventJSON(e *entities.Event) eventJSON {
+	return eventJSON{
+		ID:           e.ID,
+		GuildID:      e.GuildID,
+		ChannelID:    e.ChannelID,
+		MessageID:    e.MessageID,
+		CreatorID:    e.CreatorID,
+		Title:        e.Title,
+		Description:  e.Description,
+		Location:     e.Location,
+		MaxSlots:     e.MaxSlots,
+		MaxGuests:    e.MaxGuests,
+		ScheduledAt:  optionalTime(e.ScheduledAt),
+		EndsAt:       optionalTime(e.EndsAt),
+		Timezone:     e.Timezone,
+		WaitlistAuto: e.WaitlistAuto,
+		SeriesID:     e.SeriesID,
+		FinalizedAt:  optionalTime(e.OrganizerStep1FinalizedAt),
+		CancelledAt:  optionalTime(e.CancelledAt),
+		CancelReason: e.CancelReason,
+		CreatedAt:    e.CreatedAt,
+		UpdatedAt:    e.UpdatedAt,
+	}
+}
+
+func toParticipantsJSON(participants []entities.Participant) []participantJSON {
+	out := make([]participantJSON, len(participants))
+	positions := map[string]int{}
+	for i, p := range participants {
+		positions[p.Status]++
+		out[i] = participantJSON{
+			ID:       p.ID,
+			UserID:   p.UserID,
+			Username: p.Username,
+			Status:   p.Status,
+			Guests:   p.Guests,
+			Position: positions[p.Status],
+			JoinedAt: p.JoinedAt,
+		}
+	}
+	return out
+}
+
+// optionalTime maps the zero time, used for unset dates in the entities, to a missing field.
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,32 @@
+package httpserver
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"servbot/internal/domain"
+	"servbot/pkg/ical"
+)
+
+const calendarFeedName = "servBot"
+
+// handleCalendarFeed serves the iCal feed of the member owning the token (/calendrier).
+// Unknown tokens get a 404 so that revoked URLs look like ones that never existed.
+func (s *Server) handleCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimSuffix(r.PathValue("token"), ".ics")
+	events, err := s.calendarUC.EventsForFeedToken(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, domain.ErrCalendarNotFound) {
+			http.NotFound(w, r)
+			return
+		}
+		log.Printf("❌ Flux iCal: %v", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", ical.ContentType)
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	_, _ = w.Write([]byte(ical.Calendar(calendarFeedName, events)))
+}
@@ -0,0 +1,74 @@
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"servbot/internal/ports/input"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+// Server is the HTTP input adapter: read-only endpoints next to the Discord bot.
+type Server struct {
+	srv           *http.Server
+	eventUC       input.EventUseCase
+	participantUC input.ParticipantUseCase
+	calendarUC    input.CalendarUseCase
+	webhookUC     input.WebhookUseCase
+	apiToken      string
+}
+
+// NewServer serves the iCal feeds, and the admin API under /api when apiToken is set.
+func NewServer(
+	addr, apiToken string,
+	eventUC input.EventUseCase,
+	participantUC input.ParticipantUseCase,
+	calendarUC input.CalendarUseCase,
+	webhookUC input.WebhookUseCase,
+) *Server {
+	s := &Server{
+		eventUC:       eventUC,
+		participantUC: participantUC,
+		calendarUC:    calendarUC,
+		webhookUC:     webhookUC,
+		apiToken:      apiToken,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /calendar/{token}", s.handleCalendarFeed)
+	if apiToken != "" {
+		mux.Handle("GET /api/guilds/{guildID}/events", s.requireToken(s.handleListEvents))
+		mux.Handle("GET /api/events/{id}", s.requireToken(s.handleGetEvent))
+		mux.Handle("GET /api/events/{id}/roster", s.requireToken(s.handleExportRoster))
+		mux.Handle("GET /api/guilds/{guildID}/webhooks", s.requireToken(s.handleListWebhooks))
+		mux.Handle("POST /api/guilds/{guildID}/webhooks", s.requireToken(s.handleCreateWebhook))
+		mux.Handle("DELETE /api/guilds/{guildID}/webhooks/{id}", s.requireToken(s.handleDeleteWebhook))
+	}
+	s.srv = &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return s
+}
+
+// Start serves in the background until Shutdown is called.
+func (s *Server) Start() {
+	go func() {
+		log.Printf("🌐 Serveur HTTP à l'écoute sur %s", s.srv.Addr)
+		if err := s.srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("❌ Serveur HTTP: %v", err)
+		}
+	}()
+}
+
+func (s *Server) Shutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := s.srv.Shutdown(ctx); err != nil {
+		log.Printf("⚠️ Arrêt du serveur HTTP: %v", err)
+	}
+}
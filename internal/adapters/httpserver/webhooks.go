@@ -0,0 +1,90 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+)
+
+const maxWebhookRequestBytes = 4 << 10
+
+type webhookJSON struct {
+	ID        uint      `json:"id"`
+	GuildID   string    `json:"guild_id"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"secret,omitempty"` // only returned on creation
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type createWebhookRequest struct {
+	URL string `json:"url"`
+}
+
+func (s *Server) handleListWebhooks(w http.ResponseWriter, r *http.Request) {
+	subs, err := s.webhookUC.ListSubscriptions(r.Context(), r.PathValue("guildID"))
+	if err != nil {
+		log.Printf("❌ API liste des webhooks: %v", err)
+		writeError(w, http.StatusInternalServerError, "internal")
+		return
+	}
+	out := make([]webhookJSON, len(subs))
+	for i := range subs {
+		out[i] = toWebhookJSON(&subs[i])
+		out[i].Secret = ""
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// handleCreateWebhook registers {"url": "..."}; the signing secret is only shown in this response.
+func (s *Server) handleCreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var req createWebhookRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxWebhookRequestBytes)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_json")
+		return
+	}
+	sub, err := s.webhookUC.CreateSubscription(r.Context(), r.PathValue("guildID"), req.URL)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidWebhookURL) {
+			writeError(w, http.StatusBadRequest, domain.Code(err))
+			return
+		}
+		log.Printf("❌ API création du webhook: %v", err)
+		writeError(w, http.StatusInternalServerError, "internal")
+		return
+	}
+	writeJSON(w, http.StatusCreated, toWebhookJSON(sub))
+}
+
+func (s *Server) handleDeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		writeError(w, http.StatusNotFound, domain.Code(domain.ErrWebhookNotFound))
+		return
+	}
+	if err := s.webhookUC.DeleteSubscription(r.Context(), r.PathValue("guildID"), uint(id)); err != nil {
+		if errors.Is(err, domain.ErrWebhookNotFound) {
+			writeError(w, http.StatusNotFound, domain.Code(err))
+			return
+		}
+		log.Printf("❌ API suppression du webhook: %v", err)
+		writeError(w, http.StatusInternalServerError, "internal")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toWebhookJSON(sub *entities.WebhookSubscription) webhookJSON {
+	return webhookJSON{
+		ID:        sub.ID,
+		GuildID:   sub.GuildID,
+		URL:       sub.URL,
+		Secret:    sub.Secret,
+		CreatedAt: sub.CreatedAt,
+	}
+}
@@ -0,0 +1,25 @@
+package application
+
+import (
+	"slices"
+
+	"servbot/internal/domain/entities"
+)
+
+// canManage is the authorization policy of every management action on an event (edit, waitlist,
+// removals, questions, cancellation...): the organizer and their co-hosts may manage it.
+func canManage(event *entities.Event, userID string) bool {
+	return userID != "" && slices.Contains(event.HostIDs(), userID)
+}
+
+// canDelegate tells who may add and remove co-hosts: the organizer only, so that co-hosts
+// cannot hand the event over to someone else.
+func canDelegate(event *entities.Event, userID string) bool {
+	return userID != "" && userID == event.CreatorID
+}
+
+// CanManageEvent exposes canManage to the adapters, which check it before opening a menu or a
+// modal; the services check it again when the change is applied.
+func (s *EventService) CanManageEvent(event *entities.Event, userID string) bool {
+	return canManage(event, userID)
+}
@@ -0,0 +1,70 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+	"servbot/internal/ports/output"
+)
+
+// calendarTokenBytes is the entropy of a feed token: the URL is the only secret.
+const calendarTokenBytes = 24
+
+type CalendarService struct {
+	eventRepo output.EventRepository
+	prefsRepo output.UserPreferencesRepository
+}
+
+func NewCalendarService(eventRepo output.EventRepository, prefsRepo output.UserPreferencesRepository) *CalendarService {
+	return &CalendarService{eventRepo: eventRepo, prefsRepo: prefsRepo}
+}
+
+// UpcomingEvents returns the events the user is confirmed for that have not ended yet.
+func (s *CalendarService) UpcomingEvents(ctx context.Context, userID string) ([]entities.Event, error) {
+	return s.eventRepo.FindUpcomingByParticipant(ctx, userID, time.Now())
+}
+
+// FeedToken returns the secret of the user's iCal feed, creating it on first use.
+// renew replaces it, which revokes the previous feed URL.
+func (s *CalendarService) FeedToken(ctx context.Context, userID string, renew bool) (string, error) {
+	if !renew {
+		prefs, err := s.prefsRepo.FindByUserID(ctx, userID)
+		if err != nil {
+			return "", err
+		}
+		if prefs != nil && prefs.CalendarToken != "" {
+			return prefs.CalendarToken, nil
+		}
+	}
+	buf := make([]byte, calendarTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate calendar token: %w", err)
+	}
+	token := hex.EncodeToString(buf)
+	if err := s.prefsRepo.SetCalendarToken(ctx, userID, token); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// EventsForFeedToken returns the upcoming events of the owner of the feed token.
+func (s *CalendarService) EventsForFeedToken(ctx context.Context, token string) ([]entities.Event, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, domain.ErrCalendarNotFound
+	}
+	prefs, err := s.prefsRepo.FindByCalendarToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if prefs == nil {
+		return nil, domain.ErrCalendarNotFound
+	}
+	return s.UpcomingEvents(ctx, prefs.UserID)
+}
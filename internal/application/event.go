@@ -3,31 +3,52 @@ package application
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"servbot/internal/domain"
+	"servbot/internal/domain/domainevent"
 	"servbot/internal/domain/entities"
 	"servbot/internal/ports/output"
+	"servbot/pkg/tz"
 )
 
 type EventService struct {
 	eventRepo       output.EventRepository
 	participantRepo output.ParticipantRepository
+	seriesRepo      output.SeriesRepository
+	uow             output.UnitOfWork
+	publisher       output.DomainEventPublisher
 }
 
 func NewEventService(
 	eventRepo output.EventRepository,
 	participantRepo output.ParticipantRepository,
+	seriesRepo output.SeriesRepository,
+	uow output.UnitOfWork,
+	publisher output.DomainEventPublisher,
 ) *EventService {
 	return &EventService{
 		eventRepo:       eventRepo,
 		participantRepo: participantRepo,
+		seriesRepo:      seriesRepo,
+		uow:             uow,
+		publisher:       publisher,
 	}
 }
 
 func (s *EventService) CreateEvent(ctx context.Context, event *entities.Event, creatorUsername string) error {
-	if err := s.eventRepo.Create(ctx, event); err != nil {
+	if err := saveEvent(ctx, s.eventRepo, s.participantRepo, event, creatorUsername); err != nil {
+		return err
+	}
+	s.publisher.Publish(ctx, domainevent.EventCreated{Event: *event})
+	return nil
+}
+
+// saveEvent creates event with its organizer as the first confirmed participant.
+func saveEvent(ctx context.Context, events output.EventRepository, participants output.ParticipantRepository, event *entities.Event, creatorUsername string) error {
+	if err := events.Create(ctx, event); err != nil {
 		return err
 	}
 	username := strings.TrimSpace(creatorUsername)
@@ -41,7 +62,7 @@ func (s *EventService) CreateEvent(ctx context.Context, event *entities.Event, c
 		Status:   domain.StatusConfirmed,
 		JoinedAt: time.Now(),
 	}
-	return s.participantRepo.Create(ctx, organizer)
+	return participants.Create(ctx, organizer)
 }
 
 func (s *EventService) GetEventByMessageID(ctx context.Context, messageID string) (*entities.Event, error) {
@@ -56,36 +77,199 @@ func (s *EventService) GetEventByPrivateChannelID(ctx context.Context, privateCh
 	return s.eventRepo.FindByPrivateChannelID(ctx, privateChannelID)
 }
 
-func (s *EventService) UpdateEvent(ctx context.Context, event *entities.Event) error {
-	if event.IsEditLocked() {
+func (s *EventService) GetEventByScheduledEventID(ctx context.Context, scheduledEventID string) (*entities.Event, error) {
+	return s.eventRepo.FindByScheduledEventID(ctx, scheduledEventID)
+}
+
+// SetScheduledEventID links the event to its Discord scheduled event; "" unlinks it.
+func (s *EventService) SetScheduledEventID(ctx context.Context, eventID uint, scheduledEventID string) error {
+	return s.eventRepo.SetScheduledEventID(ctx, eventID, scheduledEventID)
+}
+
+// MarkScheduledEventStarted records that the Discord scheduled event was started at at.
+// An event started ahead of time is moved to at, which locks its edition.
+func (s *EventService) MarkScheduledEventStarted(ctx context.Context, scheduledEventID string, at time.Time) (*entities.Event, error) {
+	event, err := s.eventRepo.FindByScheduledEventID(ctx, scheduledEventID)
+	if err != nil {
+		return nil, domain.ErrEventNotFound
+	}
+	if event.IsCancelled() || event.ScheduledAt.IsZero() || !event.ScheduledAt.After(at) {
+		return event, nil
+	}
+	if err := s.eventRepo.UpdateSchedule(ctx, event.ID, at, event.EndsAt); err != nil {
+		return nil, err
+	}
+	return s.reloadEdited(ctx, event.ID)
+}
+
+// MarkScheduledEventEnded records that the Discord scheduled event was ended at at.
+// An event ended ahead of its end time gets at as its end.
+func (s *EventService) MarkScheduledEventEnded(ctx context.Context, scheduledEventID string, at time.Time) (*entities.Event, error) {
+	event, err := s.eventRepo.FindByScheduledEventID(ctx, scheduledEventID)
+	if err != nil {
+		return nil, domain.ErrEventNotFound
+	}
+	if event.IsCancelled() || event.ScheduledAt.IsZero() || !event.EndTime().After(at) {
+		return event, nil
+	}
+	scheduledAt := event.ScheduledAt
+	if scheduledAt.After(at) {
+		scheduledAt = at
+	}
+	if err := s.eventRepo.UpdateSchedule(ctx, event.ID, scheduledAt, at); err != nil {
+		return nil, err
+	}
+	return s.reloadEdited(ctx, event.ID)
+}
+
+// reloadEdited returns the event after a schedule change and publishes the edit.
+func (s *EventService) reloadEdited(ctx context.Context, eventID uint) (*entities.Event, error) {
+	event, err := s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	s.publisher.Publish(ctx, domainevent.EventEdited{Event: *event})
+	return event, nil
+}
+
+// UpdateEvent saves the edit of event made by hostID, who must manage the stored event.
+func (s *EventService) UpdateEvent(ctx context.Context, event *entities.Event, hostID string) error {
+	stored, err := s.eventRepo.FindByID(ctx, event.ID)
+	if err != nil {
+		return domain.ErrEventNotFound
+	}
+	if !canManage(stored, hostID) {
+		return domain.ErrNotOrganizer
+	}
+	if stored.IsEditLocked() || event.IsEditLocked() {
 		return domain.ErrEventAlreadyFinalized
 	}
-	confirmedCount, err := s.participantRepo.CountByEventIDAndStatus(ctx, event.ID, domain.StatusConfirmed)
+	if event.MaxGuests < 0 || event.MaxGuests > entities.MaxGuestsPerParticipant {
+		return domain.ErrInvalidGuests
+	}
+	confirmedSeats, err := s.participantRepo.CountSeatsByEventIDAndStatus(ctx, event.ID, domain.StatusConfirmed)
 	if err != nil {
-		return fmt.Errorf("count confirmed: %w", err)
+		return fmt.Errorf("count confirmed seats: %w", err)
 	}
-	if event.MaxSlots > 0 && int(confirmedCount) > event.MaxSlots {
+	offeredSeats, err := s.participantRepo.CountSeatsByEventIDAndStatus(ctx, event.ID, domain.StatusOffered)
+	if err != nil {
+		return fmt.Errorf("count offered seats: %w", err)
+	}
+	if event.MaxSlots > 0 && int(confirmedSeats+offeredSeats) > event.MaxSlots {
 		return domain.ErrCannotReduceSlots
 	}
-	return s.eventRepo.Update(ctx, event)
+	if err := s.eventRepo.Update(ctx, event); err != nil {
+		return err
+	}
+	s.publisher.Publish(ctx, domainevent.EventEdited{Event: *event})
+	return nil
+}
+
+// SetEventRoles restricts registration to allowedRoleIDs (empty = everyone) and moves members
+// with priorityRoleIDs ahead on the waitlist. Existing registrations are kept as they are. On an
+// occurrence of a series, the next occurrences get the same roles.
+func (s *EventService) SetEventRoles(ctx context.Context, eventID uint, hostID string, allowedRoleIDs, priorityRoleIDs []string) (*entities.Event, error) {
+	event, err := s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, domain.ErrEventNotFound
+	}
+	if !canManage(event, hostID) {
+		return nil, domain.ErrNotOrganizer
+	}
+	if event.IsCancelled() {
+		return nil, domain.ErrEventCancelled
+	}
+	if event.HasStarted() {
+		return nil, domain.ErrEventStarted
+	}
+	event.AllowedRoleIDs = allowedRoleIDs
+	event.PriorityRoleIDs = priorityRoleIDs
+	if err := s.eventRepo.UpdateRoles(ctx, event); err != nil {
+		return nil, err
+	}
+	if event.SeriesID != 0 {
+		series, err := s.seriesRepo.FindByID(ctx, event.SeriesID)
+		if err == nil && !series.IsCancelled() {
+			series.AllowedRoleIDs = allowedRoleIDs
+			series.PriorityRoleIDs = priorityRoleIDs
+			if err := s.seriesRepo.UpdateRoles(ctx, series); err != nil {
+				return nil, err
+			}
+		}
+	}
+	s.publisher.Publish(ctx, domainevent.EventEdited{Event: *event})
+	return event, nil
 }
 
+// AddCoHost lets userID manage eventID alongside its organizer.
+func (s *EventService) AddCoHost(ctx context.Context, eventID uint, requesterID, userID string) (*entities.Event, error) {
+	event, err := s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, domain.ErrEventNotFound
+	}
+	if !canDelegate(event, requesterID) {
+		return nil, domain.ErrNotOrganizer
+	}
+	if userID == "" || userID == event.CreatorID {
+		return nil, domain.ErrInvalidCoHost
+	}
+	if event.IsCancelled() {
+		return nil, domain.ErrEventCancelled
+	}
+	if err := s.eventRepo.AddCoHost(ctx, eventID, userID, requesterID); err != nil {
+		return nil, err
+	}
+	event.CoHostIDs = append(event.CoHostIDs, userID)
+	s.publisher.Publish(ctx, domainevent.CoHostAdded{Event: *event, UserID: userID})
+	return event, nil
+}
+
+// RemoveCoHost withdraws the management of eventID from userID; the organizer removes any
+// co-host, a co-host can step down.
+func (s *EventService) RemoveCoHost(ctx context.Context, eventID uint, requesterID, userID string) (*entities.Event, error) {
+	event, err := s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, domain.ErrEventNotFound
+	}
+	if !canDelegate(event, requesterID) && requesterID != userID {
+		return nil, domain.ErrNotOrganizer
+	}
+	if err := s.eventRepo.RemoveCoHost(ctx, eventID, userID); err != nil {
+		return nil, err
+	}
+	event.CoHostIDs = slices.DeleteFunc(event.CoHostIDs, func(id string) bool { return id == userID })
+	s.publisher.Publish(ctx, domainevent.CoHostRemoved{Event: *event, UserID: userID})
+	return event, nil
+}
+
+// GetWaitlistParticipants returns the waitlist in the order slots are offered: priority roles
+// first, then by join time.
 func (s *EventService) GetWaitlistParticipants(ctx context.Context, eventID uint) ([]entities.Participant, error) {
-	return s.participantRepo.FindByEventIDAndStatus(ctx, eventID, domain.StatusWaitlist)
+	return s.participantRepo.FindWaitlistByEventID(ctx, eventID)
 }
 
 func (s *EventService) GetConfirmedParticipants(ctx context.Context, eventID uint) ([]entities.Participant, error) {
 	return s.participantRepo.FindByEventIDAndStatus(ctx, eventID, domain.StatusConfirmed)
 }
 
-func (s *EventService) GetEventsByCreatorID(ctx context.Context, creatorID string) ([]entities.Event, error) {
-	return s.eventRepo.FindByCreatorID(ctx, creatorID)
+// GetEventsByGuildID returns the events of the guild, latest first; participants are not loaded.
+func (s *EventService) GetEventsByGuildID(ctx context.Context, guildID string) ([]entities.Event, error) {
+	return s.eventRepo.FindByGuildID(ctx, guildID)
+}
+
+func (s *EventService) GetEventsByCreatorID(ctx context.Context, guildID, creatorID string) ([]entities.Event, error) {
+	return s.eventRepo.FindByCreatorID(ctx, guildID, creatorID)
 }
 
 func (s *EventService) FindStartedNonFinalizedEvents(ctx context.Context, now time.Time) ([]entities.Event, error) {
 	return s.eventRepo.FindStartedNonFinalizedEvents(ctx, now)
 }
 
+// FindRecentlyEndedEvents returns the events that ended during the last hour.
+func (s *EventService) FindRecentlyEndedEvents(ctx context.Context, now time.Time) ([]entities.Event, error) {
+	return s.eventRepo.FindRecentlyEndedEvents(ctx, now)
+}
+
 func (s *EventService) EventsNeedingH48OrganizerDM(ctx context.Context, now time.Time) ([]entities.Event, error) {
 	return s.eventRepo.FindEventsNeedingH48OrganizerDM(ctx, now)
 }
@@ -94,19 +278,180 @@ func (s *EventService) MarkOrganizerValidationDMSent(ctx context.Context, eventI
 	return s.eventRepo.MarkOrganizerValidationDMSent(ctx, eventID)
 }
 
-func (s *EventService) FinalizeOrganizerStep1(ctx context.Context, eventID uint, creatorID string) (*entities.Event, error) {
+func (s *EventService) FinalizeOrganizerStep1(ctx context.Context, eventID uint, hostID string) (*entities.Event, error) {
 	event, err := s.eventRepo.FindByID(ctx, eventID)
 	if err != nil {
 		return nil, domain.ErrEventNotFound
 	}
-	if event.CreatorID != creatorID {
+	if !canManage(event, hostID) {
 		return nil, domain.ErrNotOrganizer
 	}
+	if event.IsCancelled() {
+		return nil, domain.ErrEventCancelled
+	}
 	if event.IsFinalized() {
 		return nil, domain.ErrEventAlreadyFinalized
 	}
 	if err := s.eventRepo.MarkOrganizerStep1Finalized(ctx, eventID); err != nil {
 		return nil, err
 	}
-	return s.eventRepo.FindByID(ctx, eventID)
+	event, err = s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	s.publisher.Publish(ctx, domainevent.EventFinalized{Event: *event})
+	return event, nil
+}
+
+// CancelEvent marks an upcoming event as cancelled and returns it with its participants,
+// so the caller can notify them and release the event's resources.
+func (s *EventService) CancelEvent(ctx context.Context, eventID uint, hostID, reason string) (*entities.Event, error) {
+	event, err := s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, domain.ErrEventNotFound
+	}
+	if !canManage(event, hostID) {
+		return nil, domain.ErrNotOrganizer
+	}
+	if event.IsCancelled() {
+		return nil, domain.ErrEventCancelled
+	}
+	if event.HasStarted() {
+		return nil, domain.ErrEventStarted
+	}
+	if err := s.eventRepo.MarkCancelled(ctx, eventID, strings.TrimSpace(reason)); err != nil {
+		return nil, err
+	}
+	event, err = s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	s.publisher.Publish(ctx, domainevent.EventCancelled{Event: *event})
+	return event, nil
+}
+
+// CreateSeries saves event as the first occurrence of a new series whose template is copied from
+// it. Both are saved in one transaction, so a failed save leaves no series behind.
+func (s *EventService) CreateSeries(ctx context.Context, event *entities.Event, recurrence, creatorUsername string) error {
+	if !domain.IsValidRecurrence(recurrence) {
+		return domain.ErrInvalidRecurrence
+	}
+	series := &entities.Series{
+		GuildID:      event.GuildID,
+		CreatorID:    event.CreatorID,
+		Recurrence:   recurrence,
+		Title:        event.Title,
+		Description:  event.Description,
+		Location:     event.Location,
+		MaxSlots:     event.MaxSlots,
+		MaxGuests:    event.MaxGuests,
+		WaitlistAuto: event.WaitlistAuto,
+		Duration:     event.Duration(),
+		Timezone:     event.Timezone,
+	}
+	if recurrence == domain.RecurrenceMonthly {
+		series.AnchorDay = event.ScheduledAt.In(tz.Location(series.Timezone)).Day()
+	}
+	series.NextAt = series.Step(event.ScheduledAt)
+	err := s.uow.Do(ctx, func(repos output.TxRepositories) error {
+		if err := repos.Series.Create(ctx, series); err != nil {
+			return err
+		}
+		event.SeriesID = series.ID
+		return saveEvent(ctx, repos.Events, repos.Participants, event, creatorUsername)
+	})
+	if err != nil {
+		return err
+	}
+	s.publisher.Publish(ctx, domainevent.EventCreated{Event: *event})
+	return nil
+}
+
+func (s *EventService) GetSeriesByID(ctx context.Context, id uint) (*entities.Series, error) {
+	series, err := s.seriesRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, domain.ErrSeriesNotFound
+	}
+	return series, nil
+}
+
+func (s *EventService) SeriesNeedingNextOccurrence(ctx context.Context, now time.Time) ([]entities.Series, error) {
+	return s.seriesRepo.FindNeedingNextOccurrence(ctx, now)
+}
+
+// CreateNextOccurrence saves an occurrence built from series.NextOccurrence and moves the series
+// forward in one transaction: when it fails, neither is saved and the occurrence can be retried.
+// ErrSeriesAlreadyAdvanced means a concurrent run saved this occurrence first.
+func (s *EventService) CreateNextOccurrence(ctx context.Context, series *entities.Series, event *entities.Event, creatorUsername string) error {
+	next := *series
+	next.NextAt = series.Step(event.ScheduledAt)
+	err := s.uow.Do(ctx, func(repos output.TxRepositories) error {
+		if err := saveEvent(ctx, repos.Events, repos.Participants, event, creatorUsername); err != nil {
+			return err
+		}
+		return repos.Series.Advance(ctx, &next, series.NextAt)
+	})
+	if err != nil {
+		return err
+	}
+	*series = next
+	s.publisher.Publish(ctx, domainevent.EventCreated{Event: *event})
+	return nil
+}
+
+// UpdateSeries applies an occurrence edit to the whole series: the occurrence itself, the template
+// of future occurrences and, when the time moved, the date of the next one.
+func (s *EventService) UpdateSeries(ctx context.Context, event *entities.Event, hostID string) error {
+	series, err := s.seriesRepo.FindByID(ctx, event.SeriesID)
+	if err != nil {
+		return domain.ErrSeriesNotFound
+	}
+	if series.IsCancelled() {
+		return domain.ErrSeriesCancelled
+	}
+	previous, err := s.eventRepo.FindByID(ctx, event.ID)
+	if err != nil {
+		return domain.ErrEventNotFound
+	}
+	if err := s.UpdateEvent(ctx, event, hostID); err != nil {
+		return err
+	}
+	series.Title = event.Title
+	series.Description = event.Description
+	series.Location = event.Location
+	series.MaxSlots = event.MaxSlots
+	series.MaxGuests = event.MaxGuests
+	series.WaitlistAuto = event.WaitlistAuto
+	series.Duration = event.Duration()
+	if !previous.ScheduledAt.IsZero() && !event.ScheduledAt.IsZero() {
+		series.NextAt = series.NextAt.Add(event.ScheduledAt.Sub(previous.ScheduledAt))
+		loc := tz.Location(series.Timezone)
+		if day := event.ScheduledAt.In(loc).Day(); series.Recurrence == domain.RecurrenceMonthly && day != previous.ScheduledAt.In(loc).Day() {
+			series.AnchorDay = day
+		}
+	}
+	return s.seriesRepo.Update(ctx, series)
+}
+
+// CancelSeries stops the generation of new occurrences after eventID; existing ones are left
+// untouched. The hosts of the occurrence may stop its series.
+func (s *EventService) CancelSeries(ctx context.Context, eventID uint, hostID string) (*entities.Series, error) {
+	event, err := s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, domain.ErrEventNotFound
+	}
+	if !canManage(event, hostID) {
+		return nil, domain.ErrNotOrganizer
+	}
+	series, err := s.seriesRepo.FindByID(ctx, event.SeriesID)
+	if err != nil {
+		return nil, domain.ErrSeriesNotFound
+	}
+	if series.IsCancelled() {
+		return nil, domain.ErrSeriesCancelled
+	}
+	if err := s.seriesRepo.Cancel(ctx, series.ID); err != nil {
+		return nil, err
+	}
+	return s.seriesRepo.FindByID(ctx, series.ID)
 }
@@ -0,0 +1,76 @@
+package application
+
+import (
+	"context"
+	"strings"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+	"servbot/internal/ports/output"
+	"servbot/pkg/tz"
+)
+
+type GuildSettingsService struct {
+	settingsRepo output.GuildSettingsRepository
+	eventRepo    output.EventRepository
+	seriesRepo   output.SeriesRepository
+	webhookRepo  output.WebhookSubscriptionRepository
+}
+
+func NewGuildSettingsService(settingsRepo output.GuildSettingsRepository, eventRepo output.EventRepository, seriesRepo output.SeriesRepository, webhookRepo output.WebhookSubscriptionRepository) *GuildSettingsService {
+	return &GuildSettingsService{settingsRepo: settingsRepo, eventRepo: eventRepo, seriesRepo: seriesRepo, webhookRepo: webhookRepo}
+}
+
+// GetGuildSettings returns the stored settings of the guild, or the defaults when /config was never used.
+func (s *GuildSettingsService) GetGuildSettings(ctx context.Context, guildID string) (*entities.GuildSettings, error) {
+	settings, err := s.settingsRepo.FindByGuildID(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		return entities.DefaultGuildSettings(guildID), nil
+	}
+	return settings, nil
+}
+
+func (s *GuildSettingsService) UpdateGuildSettings(ctx context.Context, settings *entities.GuildSettings) error {
+	settings.Locale = strings.ToLower(strings.TrimSpace(settings.Locale))
+	if !domain.IsSupportedLocale(settings.Locale) {
+		return domain.ErrInvalidLocale
+	}
+	settings.Timezone = strings.TrimSpace(settings.Timezone)
+	if !tz.Valid(settings.Timezone) {
+		return domain.ErrInvalidTimezone
+	}
+	if settings.OfferDelayHours < 1 || settings.OfferDelayHours > entities.MaxOfferDelayHours {
+		return domain.ErrInvalidOfferDelay
+	}
+	return s.settingsRepo.Save(ctx, settings)
+}
+
+// SeedGuildSettings stores the forum of the former FORUM_CHANNEL_ID/GUILD_ID setup,
+// unless the guild already has settings.
+func (s *GuildSettingsService) SeedGuildSettings(ctx context.Context, guildID, forumChannelID string) error {
+	existing, err := s.settingsRepo.FindByGuildID(ctx, guildID)
+	if err != nil || existing != nil {
+		return err
+	}
+	settings := entities.DefaultGuildSettings(guildID)
+	settings.ForumChannelID = forumChannelID
+	return s.settingsRepo.Save(ctx, settings)
+}
+
+// RemoveGuild deletes everything stored for a guild the bot was removed from.
+// Participants and questions are deleted with their events.
+func (s *GuildSettingsService) RemoveGuild(ctx context.Context, guildID string) error {
+	if err := s.eventRepo.DeleteByGuildID(ctx, guildID); err != nil {
+		return err
+	}
+	if err := s.seriesRepo.DeleteByGuildID(ctx, guildID); err != nil {
+		return err
+	}
+	if err := s.webhookRepo.DeleteByGuildID(ctx, guildID); err != nil {
+		return err
+	}
+	return s.settingsRepo.Delete(ctx, guildID)
+}
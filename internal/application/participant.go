@@ -2,10 +2,12 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"servbot/internal/domain"
+	"servbot/internal/domain/domainevent"
 	"servbot/internal/domain/entities"
 	"servbot/internal/ports/output"
 )
@@ -13,58 +15,90 @@ import (
 type ParticipantService struct {
 	participantRepo output.ParticipantRepository
 	eventRepo       output.EventRepository
+	uow             output.UnitOfWork
+	settingsRepo    output.GuildSettingsRepository
 	translator      output.T
+	publisher       output.DomainEventPublisher
 }
 
 func NewParticipantService(
 	participantRepo output.ParticipantRepository,
 	eventRepo output.EventRepository,
+	uow output.UnitOfWork,
+	settingsRepo output.GuildSettingsRepository,
 	translator output.T,
+	publisher output.DomainEventPublisher,
 ) *ParticipantService {
 	return &ParticipantService{
 		participantRepo: participantRepo,
 		eventRepo:       eventRepo,
+		uow:             uow,
+		settingsRepo:    settingsRepo,
 		translator:      translator,
+		publisher:       publisher,
 	}
 }
 
-func (s *ParticipantService) JoinEvent(ctx context.Context, locale string, eventID uint, userID, username string, forceWaitlist bool) (string, error) {
-	event, err := s.eventRepo.FindByID(ctx, eventID)
-	if err != nil {
-		return "", domain.ErrEventNotFound
-	}
-	existing, _ := s.participantRepo.FindByEventIDAndUserID(ctx, eventID, userID)
-	if existing != nil {
-		msgKey := "dm.join.already_interested"
-		if existing.Status == domain.StatusWaitlist {
-			msgKey = "dm.join.already_waitlist"
+// JoinEvent registers userID as confirmed, or on the waitlist when the event is full or
+// forceWaitlist is set. Members without one of the event's allowed roles are turned away with
+// ErrRoleNotAllowed; those with a priority role go ahead on the waitlist. The event row is
+// locked so that two joins cannot take the last slot, and a second registration of the same
+// user is rejected by the database.
+func (s *ParticipantService) JoinEvent(ctx context.Context, locale string, eventID uint, userID, username string, roleIDs []string, forceWaitlist bool) (string, error) {
+	var reply string
+	var joined domainevent.ParticipantJoined
+	err := s.uow.Do(ctx, func(repos output.TxRepositories) error {
+		event, err := repos.Events.FindByIDForUpdate(ctx, eventID)
+		if err != nil {
+			return domain.ErrEventNotFound
 		}
-		return s.translator.T(locale, msgKey, nil), domain.ErrParticipantExists
-	}
-	confirmedCount, err := s.participantRepo.CountByEventIDAndStatus(ctx, eventID, domain.StatusConfirmed)
+		if event.IsCancelled() {
+			reply = s.translator.T(locale, "dm.join.event_cancelled", map[string]any{"EventTitle": event.Title})
+			return domain.ErrEventCancelled
+		}
+		if !event.IsRoleAllowed(roleIDs) {
+			return domain.ErrRoleNotAllowed
+		}
+		taken, err := takenSeats(ctx, repos, eventID)
+		if err != nil {
+			return err
+		}
+		status := domain.StatusConfirmed
+		replyKey := "dm.join.confirmed"
+		if event.MaxSlots > 0 && taken+1 > event.MaxSlots {
+			status = domain.StatusWaitlist
+			replyKey = "dm.join.waitlist_full"
+		} else if forceWaitlist {
+			status = domain.StatusWaitlist
+			replyKey = "dm.join.waitlist_forced"
+		}
+		participant := &entities.Participant{
+			EventID:  eventID,
+			UserID:   userID,
+			Username: username,
+			Status:   status,
+			Priority: event.HasPriority(roleIDs),
+			JoinedAt: time.Now(),
+		}
+		if err := repos.Participants.Create(ctx, participant); errors.Is(err, domain.ErrParticipantExists) {
+			msgKey := "dm.join.already_interested"
+			if existing, _ := repos.Participants.FindByEventIDAndUserID(ctx, eventID, userID); existing != nil && existing.Status == domain.StatusWaitlist {
+				msgKey = "dm.join.already_waitlist"
+			}
+			reply = s.translator.T(locale, msgKey, nil)
+			return err
+		} else if err != nil {
+			return fmt.Errorf("create participant: %w", err)
+		}
+		joined = domainevent.ParticipantJoined{Event: *event, Participant: *participant}
+		reply = s.translator.T(locale, replyKey, nil)
+		return nil
+	})
 	if err != nil {
-		return "", fmt.Errorf("count confirmed: %w", err)
-	}
-	status := domain.StatusConfirmed
-	replyKey := "dm.join.confirmed"
-	if event.MaxSlots > 0 && int(confirmedCount) >= event.MaxSlots {
-		status = domain.StatusWaitlist
-		replyKey = "dm.join.waitlist_full"
-	} else if forceWaitlist {
-		status = domain.StatusWaitlist
-		replyKey = "dm.join.waitlist_forced"
-	}
-	participant := &entities.Participant{
-		EventID:  eventID,
-		UserID:   userID,
-		Username: username,
-		Status:   status,
-		JoinedAt: time.Now(),
+		return reply, err
 	}
-	if err := s.participantRepo.Create(ctx, participant); err != nil {
-		return "", fmt.Errorf("create participant: %w", err)
-	}
-	return s.translator.T(locale, replyKey, nil), nil
+	s.publisher.Publish(ctx, joined)
+	return reply, nil
 }
 
 func (s *ParticipantService) GetParticipantByEventIDAndUserID(ctx context.Context, eventID uint, userID string) (*entities.Participant, error) {
@@ -75,86 +109,422 @@ func (s *ParticipantService) GetParticipantByID(ctx context.Context, id uint) (*
 	return s.participantRepo.FindByID(ctx, id)
 }
 
-func (s *ParticipantService) LeaveEvent(ctx context.Context, eventID uint, userID string) (bool, error) {
-	participant, err := s.participantRepo.FindByEventIDAndUserID(ctx, eventID, userID)
+// GetRoster returns the confirmed participants in join order, then the waitlist in the order
+// slots are offered.
+func (s *ParticipantService) GetRoster(ctx context.Context, eventID uint) ([]entities.Participant, error) {
+	if _, err := s.eventRepo.FindByID(ctx, eventID); err != nil {
+		return nil, domain.ErrEventNotFound
+	}
+	confirmed, err := s.participantRepo.FindByEventIDAndStatus(ctx, eventID, domain.StatusConfirmed)
 	if err != nil {
-		return false, domain.ErrParticipantNotFound
+		return nil, fmt.Errorf("find confirmed: %w", err)
 	}
-	wasConfirmed := participant.Status == domain.StatusConfirmed
-	if err := s.participantRepo.Delete(ctx, participant); err != nil {
-		return false, fmt.Errorf("delete participant: %w", err)
+	waitlist, err := s.participantRepo.FindWaitlistByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("find waitlist: %w", err)
 	}
-	return wasConfirmed, nil
+	return append(confirmed, waitlist...), nil
 }
 
-// PromoteParticipant promotes a waitlist participant to confirmed; if the event was full, MaxSlots is increased by 1.
-func (s *ParticipantService) PromoteParticipant(ctx context.Context, participantID uint, creatorID string) (*entities.Participant, bool, error) {
-	participant, err := s.participantRepo.FindByID(ctx, participantID)
+// GetUpcomingRegistrations lists the upcoming events of guildID userID is registered to, with
+// their place on the waitlist.
+func (s *ParticipantService) GetUpcomingRegistrations(ctx context.Context, guildID, userID string, now time.Time) ([]entities.Registration, error) {
+	participations, err := s.participantRepo.FindUpcomingByUserID(ctx, guildID, userID, now)
 	if err != nil {
-		return nil, false, domain.ErrParticipantNotFound
+		return nil, fmt.Errorf("find upcoming participations: %w", err)
 	}
-	if participant.Status != domain.StatusWaitlist {
-		return nil, false, domain.ErrParticipantNotWaitlist
+	out := make([]entities.Registration, 0, len(participations))
+	for _, p := range participations {
+		event, err := s.eventRepo.FindByID(ctx, p.EventID)
+		if err != nil {
+			return nil, fmt.Errorf("find event %d: %w", p.EventID, err)
+		}
+		r := entities.Registration{Event: *event, Participant: p}
+		if p.Status == domain.StatusWaitlist {
+			if r.WaitlistPosition, err = s.participantRepo.WaitlistRank(ctx, &p); err != nil {
+				return nil, err
+			}
+		}
+		out = append(out, r)
 	}
-	event, err := s.eventRepo.FindByID(ctx, participant.EventID)
+	return out, nil
+}
+
+// LeaveEvent unregisters userID and reports whether they held a slot (confirmed or offered).
+func (s *ParticipantService) LeaveEvent(ctx context.Context, eventID uint, userID string) (bool, error) {
+	var event *entities.Event
+	var participant *entities.Participant
+	err := s.uow.Do(ctx, func(repos output.TxRepositories) error {
+		var err error
+		if event, err = repos.Events.FindByIDForUpdate(ctx, eventID); err != nil {
+			return domain.ErrEventNotFound
+		}
+		if participant, err = repos.Participants.FindByEventIDAndUserID(ctx, eventID, userID); err != nil {
+			return domain.ErrParticipantNotFound
+		}
+		if err := repos.Participants.Delete(ctx, participant); err != nil {
+			return fmt.Errorf("delete participant: %w", err)
+		}
+		event.Participants = withoutParticipant(event.Participants, participant.ID)
+		return nil
+	})
 	if err != nil {
-		return nil, false, domain.ErrEventNotFound
+		return false, err
 	}
-	if event.CreatorID != creatorID {
-		return nil, false, domain.ErrNotOrganizer
+	heldSlot := holdsSlot(participant)
+	s.publisher.Publish(ctx, domainevent.ParticipantLeft{Event: *event, Participant: *participant})
+	if heldSlot {
+		s.publisher.Publish(ctx, domainevent.SlotFreed{Event: *event})
 	}
-	confirmedCount, err := s.participantRepo.CountByEventIDAndStatus(ctx, event.ID, domain.StatusConfirmed)
+	return heldSlot, nil
+}
+
+// SetGuests changes the number of guests userID brings, up to the event's cap. A participant
+// holding a slot cannot take more seats than are left; the seats they give back are freed.
+func (s *ParticipantService) SetGuests(ctx context.Context, eventID uint, userID string, guests int) (*entities.Participant, error) {
+	var changed domainevent.ParticipantGuestsChanged
+	err := s.uow.Do(ctx, func(repos output.TxRepositories) error {
+		event, err := repos.Events.FindByIDForUpdate(ctx, eventID)
+		if err != nil {
+			return domain.ErrEventNotFound
+		}
+		if event.IsCancelled() {
+			return domain.ErrEventCancelled
+		}
+		if event.HasStarted() {
+			return domain.ErrEventStarted
+		}
+		if guests < 0 || guests > event.MaxGuests {
+			return domain.ErrTooManyGuests
+		}
+		participant, err := repos.Participants.FindByEventIDAndUserID(ctx, eventID, userID)
+		if err != nil {
+			return domain.ErrParticipantNotFound
+		}
+		previous := participant.Guests
+		if holdsSlot(participant) && guests > previous && event.MaxSlots > 0 {
+			taken, err := takenSeats(ctx, repos, eventID)
+			if err != nil {
+				return err
+			}
+			if taken+guests-previous > event.MaxSlots {
+				return domain.ErrEventFull
+			}
+		}
+		participant.Guests = guests
+		if err := repos.Participants.Update(ctx, participant); err != nil {
+			return fmt.Errorf("update participant: %w", err)
+		}
+		changed = domainevent.ParticipantGuestsChanged{Event: *event, Participant: *participant, Previous: previous}
+		return nil
+	})
 	if err != nil {
-		return nil, false, fmt.Errorf("count confirmed: %w", err)
+		return nil, err
 	}
-	quotaIncreased := false
-	if event.MaxSlots > 0 && int(confirmedCount) >= event.MaxSlots {
-		event.MaxSlots = int(confirmedCount) + 1
-		if err := s.eventRepo.Update(ctx, event); err != nil {
-			return nil, false, fmt.Errorf("update event: %w", err)
+	s.publisher.Publish(ctx, changed)
+	if holdsSlot(&changed.Participant) && changed.Participant.Guests < changed.Previous {
+		s.publisher.Publish(ctx, domainevent.SlotFreed{Event: changed.Event})
+	}
+	return &changed.Participant, nil
+}
+
+// PromoteParticipant promotes a waitlist participant to confirmed; if their seats do not fit,
+// MaxSlots is increased to make room for them and their guests.
+func (s *ParticipantService) PromoteParticipant(ctx context.Context, participantID uint, hostID string) (*entities.Participant, bool, error) {
+	var promoted domainevent.ParticipantPromoted
+	err := s.uow.Do(ctx, func(repos output.TxRepositories) error {
+		participant, err := repos.Participants.FindByID(ctx, participantID)
+		if err != nil {
+			return domain.ErrParticipantNotFound
+		}
+		event, err := repos.Events.FindByIDForUpdate(ctx, participant.EventID)
+		if err != nil {
+			return domain.ErrEventNotFound
+		}
+		if !canManage(event, hostID) {
+			return domain.ErrNotOrganizer
+		}
+		// Read again under the lock: the status may have changed since the first read.
+		if participant, err = repos.Participants.FindByID(ctx, participantID); err != nil {
+			return domain.ErrParticipantNotFound
 		}
-		quotaIncreased = true
+		if participant.Status != domain.StatusWaitlist {
+			return domain.ErrParticipantNotWaitlist
+		}
+		taken, err := takenSeats(ctx, repos, event.ID)
+		if err != nil {
+			return err
+		}
+		quotaIncreased := false
+		if event.MaxSlots > 0 && taken+participant.Seats() > event.MaxSlots {
+			event.MaxSlots = taken + participant.Seats()
+			if err := repos.Events.Update(ctx, event); err != nil {
+				return fmt.Errorf("update event: %w", err)
+			}
+			quotaIncreased = true
+		}
+		participant.Status = domain.StatusConfirmed
+		if err := repos.Participants.Update(ctx, participant); err != nil {
+			return fmt.Errorf("update participant: %w", err)
+		}
+		promoted = domainevent.ParticipantPromoted{
+			Event:          *event,
+			Participant:    *participant,
+			ByOrganizer:    true,
+			QuotaIncreased: quotaIncreased,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
 	}
-	participant.Status = domain.StatusConfirmed
-	if err := s.participantRepo.Update(ctx, participant); err != nil {
-		return nil, false, fmt.Errorf("update participant: %w", err)
+	s.publisher.Publish(ctx, promoted)
+	return &promoted.Participant, promoted.QuotaIncreased, nil
+}
+
+func (s *ParticipantService) RemoveParticipant(ctx context.Context, participantID uint, hostID string) (*entities.Participant, error) {
+	return s.removeParticipant(ctx, participantID, hostID, false)
+}
+
+// RefuseParticipant removes a late registration the organizer turned down.
+func (s *ParticipantService) RefuseParticipant(ctx context.Context, participantID uint, hostID string) (*entities.Participant, error) {
+	return s.removeParticipant(ctx, participantID, hostID, true)
+}
+
+func (s *ParticipantService) removeParticipant(ctx context.Context, participantID uint, hostID string, refused bool) (*entities.Participant, error) {
+	var event *entities.Event
+	var participant *entities.Participant
+	err := s.uow.Do(ctx, func(repos output.TxRepositories) error {
+		p, err := repos.Participants.FindByID(ctx, participantID)
+		if err != nil {
+			return domain.ErrParticipantNotFound
+		}
+		if event, err = repos.Events.FindByIDForUpdate(ctx, p.EventID); err != nil {
+			return domain.ErrEventNotFound
+		}
+		if !canManage(event, hostID) {
+			return domain.ErrNotOrganizer
+		}
+		if participant, err = repos.Participants.FindByID(ctx, participantID); err != nil {
+			return domain.ErrParticipantNotFound
+		}
+		if participant.Status != domain.StatusConfirmed {
+			return domain.ErrParticipantNotConfirmed
+		}
+		if err := repos.Participants.Delete(ctx, participant); err != nil {
+			return fmt.Errorf("delete participant: %w", err)
+		}
+		event.Participants = withoutParticipant(event.Participants, participant.ID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return participant, quotaIncreased, nil
+	s.publisher.Publish(ctx, domainevent.ParticipantRemoved{Event: *event, Participant: *participant, Refused: refused})
+	s.publisher.Publish(ctx, domainevent.SlotFreed{Event: *event})
+	return participant, nil
 }
 
-func (s *ParticipantService) RemoveParticipant(ctx context.Context, participantID uint, creatorID string) (*entities.Participant, error) {
-	participant, err := s.participantRepo.FindByID(ctx, participantID)
+// OfferSlotToNextWaitlist offers the free seats to the waitlisted participants they are enough
+// for, guests included, until none are left; an event without a cap offers to the first one only.
+// Candidates are taken by priority DESC, joined_at, id; each one picked holds their seats until
+// they accept or the deadline set by the guild's offer delay passes.
+func (s *ParticipantService) OfferSlotToNextWaitlist(ctx context.Context, eventID uint, now time.Time) ([]entities.Participant, error) {
+	var offered []domainevent.ParticipantOffered
+	err := s.uow.Do(ctx, func(repos output.TxRepositories) error {
+		event, err := repos.Events.FindByIDForUpdate(ctx, eventID)
+		if err != nil {
+			return domain.ErrEventNotFound
+		}
+		if event.IsCancelled() {
+			return domain.ErrEventCancelled
+		}
+		participants, err := repos.Participants.FindWaitlistByEventID(ctx, eventID)
+		if err != nil {
+			return fmt.Errorf("find waitlist: %w", err)
+		}
+		if len(participants) == 0 {
+			return domain.ErrNoWaitlistParticipant
+		}
+		taken, err := takenSeats(ctx, repos, eventID)
+		if err != nil {
+			return err
+		}
+		picked := participants[:1]
+		if event.MaxSlots > 0 {
+			picked = nil
+			free := event.MaxSlots - taken
+			for _, p := range participants {
+				if free <= 0 {
+					break
+				}
+				if p.Seats() <= free {
+					picked = append(picked, p)
+					free -= p.Seats()
+				}
+			}
+			if len(picked) == 0 {
+				return domain.ErrEventFull
+			}
+		}
+		deadline, err := s.offerDeadline(ctx, event, now)
+		if err != nil {
+			return err
+		}
+		for _, next := range picked {
+			next.Status = domain.StatusOffered
+			next.OfferExpiresAt = deadline
+			if err := repos.Participants.Update(ctx, &next); err != nil {
+				return fmt.Errorf("update participant: %w", err)
+			}
+			offered = append(offered, domainevent.ParticipantOffered{Event: *event, Participant: next})
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, domain.ErrParticipantNotFound
+		return nil, err
+	}
+	out := make([]entities.Participant, len(offered))
+	for i, e := range offered {
+		s.publisher.Publish(ctx, e)
+		out[i] = e.Participant
+	}
+	return out, nil
+}
+
+// offerDeadline is now plus the guild's offer delay, brought forward to the start of the event.
+func (s *ParticipantService) offerDeadline(ctx context.Context, event *entities.Event, now time.Time) (time.Time, error) {
+	delay := entities.DefaultOfferDelayHours
+	if settings, err := s.settingsRepo.FindByGuildID(ctx, event.GuildID); err == nil && settings != nil && settings.OfferDelayHours > 0 {
+		delay = settings.OfferDelayHours
+	}
+	deadline := now.Add(time.Duration(delay) * time.Hour)
+	if !event.ScheduledAt.IsZero() && event.ScheduledAt.Before(deadline) {
+		deadline = event.ScheduledAt
+	}
+	if !deadline.After(now) {
+		return time.Time{}, domain.ErrEventStarted
 	}
-	event, err := s.eventRepo.FindByID(ctx, participant.EventID)
+	return deadline, nil
+}
+
+// AcceptSlotOffer confirms userID on the slot offered to them.
+func (s *ParticipantService) AcceptSlotOffer(ctx context.Context, participantID uint, userID string, now time.Time) (*entities.Participant, error) {
+	var promoted domainevent.ParticipantPromoted
+	err := s.uow.Do(ctx, func(repos output.TxRepositories) error {
+		event, participant, err := lockOffer(ctx, repos, participantID, userID)
+		if err != nil {
+			return err
+		}
+		if !now.Before(participant.OfferExpiresAt) {
+			return domain.ErrOfferExpired
+		}
+		participant.Status = domain.StatusConfirmed
+		participant.OfferExpiresAt = time.Time{}
+		if err := repos.Participants.Update(ctx, participant); err != nil {
+			return fmt.Errorf("update participant: %w", err)
+		}
+		promoted = domainevent.ParticipantPromoted{Event: *event, Participant: *participant, AcceptedOffer: true}
+		return nil
+	})
 	if err != nil {
-		return nil, domain.ErrEventNotFound
+		return nil, err
 	}
-	if event.CreatorID != creatorID {
-		return nil, domain.ErrNotOrganizer
+	s.publisher.Publish(ctx, promoted)
+	return &promoted.Participant, nil
+}
+
+// DeclineSlotOffer unregisters userID from the event whose slot was offered to them; the slot
+// is then offered to the next participant of the waitlist.
+func (s *ParticipantService) DeclineSlotOffer(ctx context.Context, participantID uint, userID string) (*entities.Participant, error) {
+	return s.withdrawOffer(ctx, participantID, userID, time.Time{})
+}
+
+// ExpireSlotOffers withdraws the offers whose deadline has passed, as if they were declined.
+func (s *ParticipantService) ExpireSlotOffers(ctx context.Context, now time.Time) error {
+	expired, err := s.participantRepo.FindExpiredOffers(ctx, now)
+	if err != nil {
+		return fmt.Errorf("find expired offers: %w", err)
 	}
-	if participant.Status != domain.StatusConfirmed {
-		return nil, domain.ErrParticipantNotConfirmed
+	var errs []error
+	for _, p := range expired {
+		_, err := s.withdrawOffer(ctx, p.ID, p.UserID, now)
+		if err != nil && !errors.Is(err, domain.ErrOfferNotFound) {
+			errs = append(errs, fmt.Errorf("expire offer %d: %w", p.ID, err))
+		}
 	}
-	if err := s.participantRepo.Delete(ctx, participant); err != nil {
-		return nil, fmt.Errorf("delete participant: %w", err)
+	return errors.Join(errs...)
+}
+
+// withdrawOffer unregisters an offered participant. With a non-zero expiredAt, the offer is
+// only withdrawn if its deadline has passed by then.
+func (s *ParticipantService) withdrawOffer(ctx context.Context, participantID uint, userID string, expiredAt time.Time) (*entities.Participant, error) {
+	var event *entities.Event
+	var participant *entities.Participant
+	err := s.uow.Do(ctx, func(repos output.TxRepositories) error {
+		var err error
+		if event, participant, err = lockOffer(ctx, repos, participantID, userID); err != nil {
+			return err
+		}
+		if !expiredAt.IsZero() && participant.OfferExpiresAt.After(expiredAt) {
+			return domain.ErrOfferNotFound
+		}
+		if err := repos.Participants.Delete(ctx, participant); err != nil {
+			return fmt.Errorf("delete participant: %w", err)
+		}
+		event.Participants = withoutParticipant(event.Participants, participant.ID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	s.publisher.Publish(ctx, domainevent.ParticipantDeclinedOffer{Event: *event, Participant: *participant, Expired: !expiredAt.IsZero()})
+	s.publisher.Publish(ctx, domainevent.SlotFreed{Event: *event})
 	return participant, nil
 }
 
-func (s *ParticipantService) GetNextWaitlistParticipant(ctx context.Context, eventID uint) (*entities.Participant, error) {
-	participants, err := s.participantRepo.FindByEventIDAndStatus(ctx, eventID, domain.StatusWaitlist)
+// lockOffer locks the event of the participant and checks that a slot is still offered to userID.
+func lockOffer(ctx context.Context, repos output.TxRepositories, participantID uint, userID string) (*entities.Event, *entities.Participant, error) {
+	p, err := repos.Participants.FindByID(ctx, participantID)
 	if err != nil {
-		return nil, fmt.Errorf("find waitlist: %w", err)
+		return nil, nil, domain.ErrOfferNotFound
 	}
-	if len(participants) == 0 {
-		return nil, domain.ErrNoWaitlistParticipant
+	event, err := repos.Events.FindByIDForUpdate(ctx, p.EventID)
+	if err != nil {
+		return nil, nil, domain.ErrEventNotFound
+	}
+	participant, err := repos.Participants.FindByID(ctx, participantID)
+	if err != nil || participant.UserID != userID || participant.Status != domain.StatusOffered {
+		return nil, nil, domain.ErrOfferNotFound
 	}
-	oldest := participants[0]
-	oldest.Status = domain.StatusConfirmed
-	if err := s.participantRepo.Update(ctx, &oldest); err != nil {
-		return nil, fmt.Errorf("update participant: %w", err)
+	return event, participant, nil
+}
+
+// takenSeats counts the seats of the confirmed participants and of the pending slot offers of
+// an event, guests included.
+func takenSeats(ctx context.Context, repos output.TxRepositories, eventID uint) (int, error) {
+	confirmed, err := repos.Participants.CountSeatsByEventIDAndStatus(ctx, eventID, domain.StatusConfirmed)
+	if err != nil {
+		return 0, fmt.Errorf("count confirmed seats: %w", err)
+	}
+	offered, err := repos.Participants.CountSeatsByEventIDAndStatus(ctx, eventID, domain.StatusOffered)
+	if err != nil {
+		return 0, fmt.Errorf("count offered seats: %w", err)
+	}
+	return int(confirmed + offered), nil
+}
+
+func holdsSlot(p *entities.Participant) bool {
+	return p.Status == domain.StatusConfirmed || p.Status == domain.StatusOffered
+}
+
+// withoutParticipant drops the participant deleted in the transaction from the loaded event.
+func withoutParticipant(participants []entities.Participant, id uint) []entities.Participant {
+	out := make([]entities.Participant, 0, len(participants))
+	for _, p := range participants {
+		if p.ID != id {
+			out = append(out, p)
+		}
 	}
-	return &oldest, nil
+	return out
 }
@@ -0,0 +1,110 @@
+package application
+
+import (
+	"context"
+	"strings"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+	"servbot/internal/ports/output"
+)
+
+type QuestionService struct {
+	questionRepo output.QuestionRepository
+	eventRepo    output.EventRepository
+}
+
+func NewQuestionService(
+	questionRepo output.QuestionRepository,
+	eventRepo output.EventRepository,
+) *QuestionService {
+	return &QuestionService{
+		questionRepo: questionRepo,
+		eventRepo:    eventRepo,
+	}
+}
+
+func (s *QuestionService) AskQuestion(ctx context.Context, eventID uint, askerID, askerName, text string) (*entities.Question, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, domain.ErrQuestionRequired
+	}
+	event, err := s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, domain.ErrEventNotFound
+	}
+	if event.IsCancelled() {
+		return nil, domain.ErrEventCancelled
+	}
+	question := &entities.Question{
+		EventID:   event.ID,
+		AskerID:   askerID,
+		AskerName: askerName,
+		Text:      text,
+	}
+	if err := s.questionRepo.Create(ctx, question); err != nil {
+		return nil, err
+	}
+	return question, nil
+}
+
+func (s *QuestionService) AttachThreadMessage(ctx context.Context, questionID uint, messageID string) error {
+	return s.questionRepo.SetThreadMessageID(ctx, questionID, messageID)
+}
+
+func (s *QuestionService) GetQuestionByID(ctx context.Context, id uint) (*entities.Question, error) {
+	question, err := s.questionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, domain.ErrQuestionNotFound
+	}
+	return question, nil
+}
+
+// AnswerQuestion records the organizer's answer; a question can only be answered once, even
+// when two hosts answer it at the same time.
+// When publish is set, the Q&A is also added to the event's public FAQ.
+func (s *QuestionService) AnswerQuestion(ctx context.Context, questionID uint, answererID, answer string, publish bool) (*entities.Question, error) {
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return nil, domain.ErrAnswerRequired
+	}
+	question, err := s.questionRepo.FindByID(ctx, questionID)
+	if err != nil {
+		return nil, domain.ErrQuestionNotFound
+	}
+	event, err := s.eventRepo.FindByID(ctx, question.EventID)
+	if err != nil {
+		return nil, domain.ErrEventNotFound
+	}
+	if !canManage(event, answererID) {
+		return nil, domain.ErrNotOrganizer
+	}
+	if question.IsAnswered() {
+		return nil, domain.ErrQuestionAlreadyAnswered
+	}
+	question.Answer = answer
+	question.AnswererID = answererID
+	if err := s.questionRepo.Answer(ctx, question, publish); err != nil {
+		return nil, err
+	}
+	return s.questionRepo.FindByID(ctx, question.ID)
+}
+
+func (s *QuestionService) GetUnansweredQuestions(ctx context.Context, eventID uint, requesterID string) ([]entities.Question, error) {
+	event, err := s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, domain.ErrEventNotFound
+	}
+	if !canManage(event, requesterID) {
+		return nil, domain.ErrNotOrganizer
+	}
+	return s.questionRepo.FindUnansweredByEventID(ctx, eventID)
+}
+
+func (s *QuestionService) GetPublishedQuestions(ctx context.Context, eventID uint) ([]entities.Question, error) {
+	return s.questionRepo.FindPublishedByEventID(ctx, eventID)
+}
+
+func (s *QuestionService) AttachFAQMessage(ctx context.Context, eventID uint, messageID string) error {
+	return s.eventRepo.SetFAQMessageID(ctx, eventID, messageID)
+}
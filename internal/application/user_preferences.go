@@ -0,0 +1,38 @@
+package application
+
+import (
+	"context"
+	"strings"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+	"servbot/internal/ports/output"
+)
+
+type UserPreferencesService struct {
+	prefsRepo output.UserPreferencesRepository
+}
+
+func NewUserPreferencesService(prefsRepo output.UserPreferencesRepository) *UserPreferencesService {
+	return &UserPreferencesService{prefsRepo: prefsRepo}
+}
+
+// GetUserPreferences returns the stored preferences of the user, or empty ones when /langue was never used.
+func (s *UserPreferencesService) GetUserPreferences(ctx context.Context, userID string) (*entities.UserPreferences, error) {
+	prefs, err := s.prefsRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if prefs == nil {
+		return &entities.UserPreferences{UserID: userID}, nil
+	}
+	return prefs, nil
+}
+
+func (s *UserPreferencesService) SetUserLocale(ctx context.Context, userID, locale string) error {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if !domain.IsSupportedLocale(locale) {
+		return domain.ErrInvalidLocale
+	}
+	return s.prefsRepo.Save(ctx, &entities.UserPreferences{UserID: userID, Locale: locale})
+}
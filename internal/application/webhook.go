@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+	"servbot/internal/ports/output"
+)
+
+const webhookSecretBytes = 32
+
+type WebhookService struct {
+	subsRepo output.WebhookSubscriptionRepository
+}
+
+func NewWebhookService(subsRepo output.WebhookSubscriptionRepository) *WebhookService {
+	return &WebhookService{subsRepo: subsRepo}
+}
+
+// CreateSubscription registers rawURL for the notifications of the guild, with a new signing secret.
+func (s *WebhookService) CreateSubscription(ctx context.Context, guildID, rawURL string) (*entities.WebhookSubscription, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, domain.ErrInvalidWebhookURL
+	}
+	buf := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("generate webhook secret: %w", err)
+	}
+	sub := &entities.WebhookSubscription{
+		GuildID: guildID,
+		URL:     rawURL,
+		Secret:  hex.EncodeToString(buf),
+	}
+	if err := s.subsRepo.Create(ctx, sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (s *WebhookService) ListSubscriptions(ctx context.Context, guildID string) ([]entities.WebhookSubscription, error) {
+	return s.subsRepo.FindByGuildID(ctx, guildID)
+}
+
+func (s *WebhookService) DeleteSubscription(ctx context.Context, guildID string, id uint) error {
+	return s.subsRepo.Delete(ctx, guildID, id)
+}
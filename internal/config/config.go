@@ -9,11 +9,17 @@ import (
 	"github.com/joho/godotenv"
 )
 
+// minAPITokenLength évite qu'un jeton trop court ne protège l'API d'administration.
+const minAPITokenLength = 32
+
 type Config struct {
 	Token          string
 	ForumChannelID string
 	DatabaseURL    string
 	GuildID        string
+	HTTPAddr       string // adresse d'écoute du serveur HTTP, "" = désactivé
+	PublicURL      string // URL publique du serveur HTTP, utilisée dans les liens envoyés aux membres
+	APIToken       string // jeton Bearer de l'API d'administration, "" = API désactivée
 }
 
 // Load charge la configuration depuis les variables d'environnement et la valide.
@@ -27,6 +33,9 @@ func Load() (*Config, error) {
 		ForumChannelID: os.Getenv("FORUM_CHANNEL_ID"),
 		DatabaseURL:    os.Getenv("DATABASE_URL"),
 		GuildID:        os.Getenv("GUILD_ID"),
+		HTTPAddr:       strings.TrimSpace(os.Getenv("HTTP_ADDR")),
+		PublicURL:      strings.TrimRight(strings.TrimSpace(os.Getenv("PUBLIC_URL")), "/"),
+		APIToken:       strings.TrimSpace(os.Getenv("API_TOKEN")),
 	}
 
 	if err := cfg.validate(); err != nil {
@@ -42,10 +51,8 @@ func (c *Config) validate() error {
 		return fmt.Errorf("config: TOKEN est requis et ne peut pas être vide")
 	}
 
-	if strings.TrimSpace(c.ForumChannelID) == "" {
-		return fmt.Errorf("config: FORUM_CHANNEL_ID est requis et ne peut pas être vide")
-	}
-
+	// FORUM_CHANNEL_ID est optionnel : le forum se configure par serveur avec /config.
+	// S'il est fourni, il sert uniquement à initialiser les paramètres de son serveur.
 	for _, r := range c.ForumChannelID {
 		if r < '0' || r > '9' {
 			return fmt.Errorf("config: FORUM_CHANNEL_ID doit être un ID de salon Discord (chiffres uniquement)")
@@ -65,5 +72,27 @@ func (c *Config) validate() error {
 		return fmt.Errorf("config: DATABASE_URL invalide (%q): scheme ou host manquant", c.DatabaseURL)
 	}
 
+	if c.APIToken != "" {
+		if c.HTTPAddr == "" {
+			return fmt.Errorf("config: API_TOKEN nécessite HTTP_ADDR")
+		}
+		if len(c.APIToken) < minAPITokenLength {
+			return fmt.Errorf("config: API_TOKEN doit faire au moins %d caractères", minAPITokenLength)
+		}
+	}
+
+	if c.PublicURL != "" {
+		if c.HTTPAddr == "" {
+			return fmt.Errorf("config: PUBLIC_URL nécessite HTTP_ADDR")
+		}
+		public, err := url.Parse(c.PublicURL)
+		if err != nil {
+			return fmt.Errorf("config: PUBLIC_URL invalide (%q): %w", c.PublicURL, err)
+		}
+		if (public.Scheme != "http" && public.Scheme != "https") || public.Host == "" {
+			return fmt.Errorf("config: PUBLIC_URL invalide (%q): URL http(s) attendue", c.PublicURL)
+		}
+	}
+
 	return nil
 }
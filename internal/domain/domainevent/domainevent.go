@@ -0,0 +1,114 @@
+// Package domainevent defines the facts published by the application services once a change
+// is saved. Adapters subscribe to them to apply the side effects: Discord messages and
+// permissions, webhooks.
+package domainevent
+
+import "servbot/internal/domain/entities"
+
+// Event is a published fact; Name is stable and also used as the webhook notification type.
+type Event interface {
+	Name() string
+}
+
+type EventCreated struct {
+	Event entities.Event
+}
+
+type EventEdited struct {
+	Event entities.Event
+}
+
+// EventFinalized carries the event with its participants.
+type EventFinalized struct {
+	Event entities.Event
+}
+
+// EventCancelled carries the event with the participants to notify.
+type EventCancelled struct {
+	Event entities.Event
+}
+
+type ParticipantJoined struct {
+	Event       entities.Event
+	Participant entities.Participant
+}
+
+// ParticipantLeft is published when a member unregisters themselves.
+type ParticipantLeft struct {
+	Event       entities.Event
+	Participant entities.Participant
+}
+
+// ParticipantRemoved is published when the organizer removes a confirmed participant;
+// Refused is set when the organizer turned down a late registration.
+type ParticipantRemoved struct {
+	Event       entities.Event
+	Participant entities.Participant
+	Refused     bool
+}
+
+// ParticipantPromoted is published when a waitlisted participant gets a confirmed place,
+// by the organizer or by accepting a slot offer.
+type ParticipantPromoted struct {
+	Event          entities.Event
+	Participant    entities.Participant
+	ByOrganizer    bool
+	QuotaIncreased bool
+	AcceptedOffer  bool
+}
+
+// ParticipantOffered is published when a freed slot is offered to the first of the waitlist,
+// who has until Participant.OfferExpiresAt to accept it.
+type ParticipantOffered struct {
+	Event       entities.Event
+	Participant entities.Participant
+}
+
+// ParticipantDeclinedOffer is published when a slot offer is declined, or has expired when
+// Expired is set. The participant is unregistered and a SlotFreed follows.
+type ParticipantDeclinedOffer struct {
+	Event       entities.Event
+	Participant entities.Participant
+	Expired     bool
+}
+
+// ParticipantGuestsChanged is published when a participant changes the number of guests they
+// bring; Previous is the number before the change.
+type ParticipantGuestsChanged struct {
+	Event       entities.Event
+	Participant entities.Participant
+	Previous    int
+}
+
+// CoHostAdded is published when the organizer shares the management of the event with UserID.
+type CoHostAdded struct {
+	Event  entities.Event
+	UserID string
+}
+
+// CoHostRemoved is published when UserID no longer co-hosts the event.
+type CoHostRemoved struct {
+	Event  entities.Event
+	UserID string
+}
+
+// SlotFreed follows the departure of a participant who held a slot, or a participant bringing
+// fewer guests.
+type SlotFreed struct {
+	Event entities.Event
+}
+
+func (EventCreated) Name() string             { return "event.created" }
+func (EventEdited) Name() string              { return "event.edited" }
+func (EventFinalized) Name() string           { return "event.finalized" }
+func (EventCancelled) Name() string           { return "event.cancelled" }
+func (ParticipantJoined) Name() string        { return "participant.joined" }
+func (ParticipantLeft) Name() string          { return "participant.left" }
+func (ParticipantRemoved) Name() string       { return "participant.removed" }
+func (ParticipantPromoted) Name() string      { return "participant.promoted" }
+func (ParticipantOffered) Name() string       { return "participant.offered" }
+func (ParticipantDeclinedOffer) Name() string { return "participant.declined_offer" }
+func (ParticipantGuestsChanged) Name() string { return "participant.guests_changed" }
+func (CoHostAdded) Name() string              { return "event.cohost_added" }
+func (CoHostRemoved) Name() string            { return "event.cohost_removed" }
+func (SlotFreed) Name() string                { return "slot.freed" }
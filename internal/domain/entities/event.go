@@ -1,34 +1,120 @@
 package entities
 
-import "time"
+import (
+	"slices"
+	"time"
+
+	"servbot/internal/domain"
+)
+
+// DefaultEventDuration is assumed for events created without an end time.
+const DefaultEventDuration = 2 * time.Hour
 
 func (e *Event) IsFinalized() bool {
 	return !e.OrganizerStep1FinalizedAt.IsZero()
 }
 
+func (e *Event) IsCancelled() bool {
+	return !e.CancelledAt.IsZero()
+}
+
 func (e *Event) HasStarted() bool {
 	return !e.ScheduledAt.IsZero() && e.ScheduledAt.Before(time.Now())
 }
 
-// Cas A: finalisé ; Cas B: sortie commencée.
+// Duration is the length set by the organizer, 0 when no end time was given.
+func (e *Event) Duration() time.Duration {
+	if e.ScheduledAt.IsZero() || e.EndsAt.IsZero() {
+		return 0
+	}
+	return e.EndsAt.Sub(e.ScheduledAt)
+}
+
+// EndTime returns EndsAt, or ScheduledAt + DefaultEventDuration when no end time was given.
+func (e *Event) EndTime() time.Time {
+	if !e.EndsAt.IsZero() {
+		return e.EndsAt
+	}
+	if e.ScheduledAt.IsZero() {
+		return time.Time{}
+	}
+	return e.ScheduledAt.Add(DefaultEventDuration)
+}
+
+func (e *Event) HasEnded() bool {
+	end := e.EndTime()
+	return !end.IsZero() && end.Before(time.Now())
+}
+
+// TakenSeats counts the seats, guests included, of the loaded participants who hold a slot:
+// confirmed, or with a pending slot offer.
+func (e *Event) TakenSeats() int {
+	taken := 0
+	for _, p := range e.Participants {
+		if p.Status == domain.StatusConfirmed || p.Status == domain.StatusOffered {
+			taken += p.Seats()
+		}
+	}
+	return taken
+}
+
+// HostIDs returns the members who manage the event: the organizer first, then the co-hosts.
+func (e *Event) HostIDs() []string {
+	return append([]string{e.CreatorID}, e.CoHostIDs...)
+}
+
+// IsRoleAllowed reports whether a member with roleIDs may register: the event is open to
+// everyone, or they have one of its allowed roles.
+func (e *Event) IsRoleAllowed(roleIDs []string) bool {
+	return len(e.AllowedRoleIDs) == 0 || hasAnyRole(e.AllowedRoleIDs, roleIDs)
+}
+
+// HasPriority reports whether a member with roleIDs goes ahead on the waitlist.
+func (e *Event) HasPriority(roleIDs []string) bool {
+	return hasAnyRole(e.PriorityRoleIDs, roleIDs)
+}
+
+func hasAnyRole(eventRoleIDs, roleIDs []string) bool {
+	for _, id := range roleIDs {
+		if slices.Contains(eventRoleIDs, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// Cas A: finalisé ; Cas B: sortie commencée ; Cas C: annulée.
 func (e *Event) IsEditLocked() bool {
-	return e.IsFinalized() || e.HasStarted()
+	return e.IsFinalized() || e.HasStarted() || e.IsCancelled()
 }
 
 type Event struct {
 	ID                          uint
+	GuildID                     string
 	MessageID                   string
 	ChannelID                   string
 	CreatorID                   string
+	CoHostIDs                   []string // members the organizer shares the management with
 	Title                       string
 	Description                 string
-	MaxSlots                    int
+	Location                    string    // free-text address, "" = not set
+	MaxSlots                    int       // seats, participants and their guests, 0 = unlimited
+	MaxGuests                   int       // guests a participant may bring, 0 = none
+	AllowedRoleIDs              []string  // roles allowed to register, empty = everyone
+	PriorityRoleIDs             []string  // roles moved ahead on the waitlist
 	ScheduledAt                 time.Time // zero = not set (for backward compat)
+	EndsAt                      time.Time // zero = no end time given, see EndTime
+	Timezone                    string    // IANA name the event was created in
 	PrivateChannelID            string    // salon privé organisateur seul (+ bot)
 	QuestionsThreadID           string    // thread "Questions" dans ce salon
+	FAQMessageID                string    // message FAQ dans le post forum
+	ScheduledEventID            string    // événement Discord créé à la finalisation, "" = aucun
 	WaitlistAuto                bool
+	SeriesID                    uint // 0 = one-off event
 	OrganizerValidationDMSentAt time.Time
 	OrganizerStep1FinalizedAt   time.Time
+	CancelledAt                 time.Time
+	CancelReason                string
 	Participants                []Participant
 	CreatedAt                   time.Time
 	UpdatedAt                   time.Time
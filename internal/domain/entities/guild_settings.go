@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"time"
+
+	"servbot/internal/domain"
+)
+
+const (
+	DefaultTimezone        = "Europe/Paris"
+	DefaultOfferDelayHours = 12
+	MaxOfferDelayHours     = 72
+)
+
+// DefaultGuildSettings returns the settings used by a guild that never ran /config.
+func DefaultGuildSettings(guildID string) *GuildSettings {
+	return &GuildSettings{
+		GuildID:         guildID,
+		Locale:          domain.LocaleFR,
+		Timezone:        DefaultTimezone,
+		WaitlistAuto:    true,
+		OfferDelayHours: DefaultOfferDelayHours,
+	}
+}
+
+// GuildSettings holds the per-server configuration edited with /config.
+type GuildSettings struct {
+	GuildID           string
+	ForumChannelID    string // forum where events are posted; empty = not configured
+	PrivateCategoryID string // category of the private channels; empty = the forum's category
+	Locale            string
+	Timezone          string // IANA name, e.g. Europe/Paris
+	WaitlistAuto      bool   // default waitlist mode of new events
+	OfferDelayHours   int    // time given to accept a slot offered from the waitlist
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
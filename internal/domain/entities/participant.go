@@ -2,13 +2,25 @@ package entities
 
 import "time"
 
+// MaxGuestsPerParticipant bounds the guest cap an organizer can set on an event.
+const MaxGuestsPerParticipant = 5
+
 type Participant struct {
-	ID        uint
-	EventID   uint
-	UserID    string
-	Username  string
-	Status    string
-	JoinedAt  time.Time
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID       uint
+	EventID  uint
+	UserID   string
+	Username string
+	Status   string
+	Guests   int  // people brought along who are not on the Discord
+	Priority bool // registered with a priority role, ahead on the waitlist
+	JoinedAt time.Time
+	// OfferExpiresAt is the deadline to accept the slot while Status is StatusOffered.
+	OfferExpiresAt time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Seats is the number of places the participant takes: themselves and their guests.
+func (p *Participant) Seats() int {
+	return 1 + p.Guests
 }
@@ -0,0 +1,27 @@
+package entities
+
+import "time"
+
+func (q *Question) IsAnswered() bool {
+	return !q.AnsweredAt.IsZero()
+}
+
+func (q *Question) IsPublished() bool {
+	return !q.PublishedAt.IsZero()
+}
+
+// Question is a member question on an event; ThreadMessageID is its message in the Questions thread.
+type Question struct {
+	ID              uint
+	EventID         uint
+	AskerID         string
+	AskerName       string
+	Text            string
+	ThreadMessageID string
+	Answer          string
+	AnswererID      string
+	AnsweredAt      time.Time
+	PublishedAt     time.Time // zero = answer sent by DM only
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
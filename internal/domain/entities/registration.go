@@ -0,0 +1,8 @@
+package entities
+
+// Registration is a member's participation in an event, as listed by /mes-sorties.
+type Registration struct {
+	Event            Event
+	Participant      Participant
+	WaitlistPosition int // 1-based, 0 unless Participant is on the waitlist
+}
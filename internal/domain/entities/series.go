@@ -0,0 +1,81 @@
+package entities
+
+import (
+	"time"
+
+	"servbot/internal/domain"
+)
+
+// Series is the template of a recurring event; NextAt is the date of the next occurrence to publish.
+type Series struct {
+	ID              uint
+	GuildID         string
+	CreatorID       string
+	Recurrence      string
+	Title           string
+	Description     string
+	Location        string
+	MaxSlots        int
+	MaxGuests       int
+	AllowedRoleIDs  []string
+	PriorityRoleIDs []string
+	WaitlistAuto    bool
+	NextAt          time.Time
+	Duration        time.Duration // 0 = occurrences have no end time
+	Timezone        string
+	AnchorDay       int // day of the month of monthly occurrences, 0 = the day of the one stepped from
+	CancelledAt     time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (s *Series) IsCancelled() bool {
+	return !s.CancelledAt.IsZero()
+}
+
+// Step returns the occurrence following t. Dates are computed in t's location so the
+// wall-clock time is kept across DST changes; monthly steps land on AnchorDay, clamped to the
+// end of shorter months, so that a series of the 31st comes back to the 31st after February.
+func (s *Series) Step(t time.Time) time.Time {
+	switch s.Recurrence {
+	case domain.RecurrenceMonthly:
+		day := s.AnchorDay
+		if day == 0 {
+			day = t.Day()
+		}
+		firstOfNext := time.Date(t.Year(), t.Month()+1, 1, t.Hour(), t.Minute(), t.Second(), 0, t.Location())
+		lastDay := firstOfNext.AddDate(0, 1, -1).Day()
+		return firstOfNext.AddDate(0, 0, min(day, lastDay)-1)
+	default:
+		return t.AddDate(0, 0, 7)
+	}
+}
+
+// NextOccurrence builds the unsaved occurrence following the series' template.
+// Occurrences missed while the bot was offline are skipped.
+func (s *Series) NextOccurrence(now time.Time, loc *time.Location) *Event {
+	at := s.NextAt.In(loc)
+	for !at.After(now) {
+		at = s.Step(at)
+	}
+	var end time.Time
+	if s.Duration > 0 {
+		end = at.Add(s.Duration)
+	}
+	return &Event{
+		GuildID:         s.GuildID,
+		CreatorID:       s.CreatorID,
+		Title:           s.Title,
+		Description:     s.Description,
+		Location:        s.Location,
+		MaxSlots:        s.MaxSlots,
+		MaxGuests:       s.MaxGuests,
+		AllowedRoleIDs:  s.AllowedRoleIDs,
+		PriorityRoleIDs: s.PriorityRoleIDs,
+		ScheduledAt:     at,
+		EndsAt:          end,
+		Timezone:        s.Timezone,
+		WaitlistAuto:    s.WaitlistAuto,
+		SeriesID:        s.ID,
+	}
+}
@@ -0,0 +1,34 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"servbot/internal/domain"
+)
+
+func TestSeriesStepMonthlyKeepsAnchorDay(t *testing.T) {
+	loc := time.FixedZone("UTC+1", 60*60)
+	s := &Series{Recurrence: domain.RecurrenceMonthly, AnchorDay: 31}
+	at := time.Date(2027, time.January, 31, 19, 0, 0, 0, loc)
+	want := []time.Time{
+		time.Date(2027, time.February, 28, 19, 0, 0, 0, loc),
+		time.Date(2027, time.March, 31, 19, 0, 0, 0, loc),
+		time.Date(2027, time.April, 30, 19, 0, 0, 0, loc),
+		time.Date(2027, time.May, 31, 19, 0, 0, 0, loc),
+	}
+	for _, w := range want {
+		at = s.Step(at)
+		if !at.Equal(w) {
+			t.Fatalf("Step = %v, want %v", at, w)
+		}
+	}
+}
+
+func TestSeriesStepWeekly(t *testing.T) {
+	s := &Series{Recurrence: domain.RecurrenceWeekly}
+	at := time.Date(2027, time.March, 25, 19, 0, 0, 0, time.UTC)
+	if got, want := s.Step(at), time.Date(2027, time.April, 1, 19, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Step = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,12 @@
+package entities
+
+import "time"
+
+// UserPreferences holds the per-member settings edited with /langue.
+type UserPreferences struct {
+	UserID        string
+	Locale        string // locale of the DMs; empty = bot default
+	CalendarToken string // secret of the iCal feed URL; empty = feed never requested
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,20 @@
+package entities
+
+import "time"
+
+// WebhookSubscription is an URL receiving the lifecycle notifications of a guild's events.
+type WebhookSubscription struct {
+	ID        uint
+	GuildID   string
+	URL       string
+	Secret    string // HMAC-SHA256 key of the X-Servbot-Signature header
+	CreatedAt time.Time
+}
+
+// WebhookNotification is one lifecycle change, delivered to every subscription of the event's guild.
+type WebhookNotification struct {
+	Type        string // name of the domain event, e.g. "participant.joined"
+	Event       Event
+	Participant *Participant // nil for event.* notifications
+	OccurredAt  time.Time
+}
@@ -39,12 +39,39 @@ func Code(err error) string {
 var (
 	ErrEventNotFound           = &Error{code: "event_not_found"}
 	ErrDateTimeInPast          = &Error{code: "datetime_in_past"}
+	ErrInvalidDateTime         = &Error{code: "invalid_datetime"}
+	ErrInvalidEndTime          = &Error{code: "invalid_end_time"}
 	ErrParticipantNotFound     = &Error{code: "participant_not_found"}
 	ErrParticipantExists       = &Error{code: "participant_exists"}
 	ErrParticipantNotWaitlist  = &Error{code: "participant_not_waitlist"}
 	ErrParticipantNotConfirmed = &Error{code: "participant_not_confirmed"}
 	ErrNoWaitlistParticipant   = &Error{code: "no_waitlist_participant"}
+	ErrEventFull               = &Error{code: "event_full"}
+	ErrInvalidGuests           = &Error{code: "invalid_guests"}
+	ErrTooManyGuests           = &Error{code: "too_many_guests"}
+	ErrRoleNotAllowed          = &Error{code: "role_not_allowed"}
+	ErrOfferNotFound           = &Error{code: "offer_not_found"}
+	ErrOfferExpired            = &Error{code: "offer_expired"}
+	ErrInvalidOfferDelay       = &Error{code: "invalid_offer_delay"}
 	ErrCannotReduceSlots       = &Error{code: "cannot_reduce_slots"}
 	ErrNotOrganizer            = &Error{code: "not_organizer"}
+	ErrAlreadyCoHost           = &Error{code: "already_cohost"}
+	ErrCoHostNotFound          = &Error{code: "cohost_not_found"}
+	ErrInvalidCoHost           = &Error{code: "invalid_cohost"}
 	ErrEventAlreadyFinalized   = &Error{code: "event_already_finalized"}
+	ErrEventCancelled          = &Error{code: "event_cancelled"}
+	ErrEventStarted            = &Error{code: "event_started"}
+	ErrSeriesNotFound          = &Error{code: "series_not_found"}
+	ErrSeriesCancelled         = &Error{code: "series_cancelled"}
+	ErrSeriesAlreadyAdvanced   = &Error{code: "series_already_advanced"}
+	ErrInvalidRecurrence       = &Error{code: "invalid_recurrence"}
+	ErrQuestionNotFound        = &Error{code: "question_not_found"}
+	ErrQuestionRequired        = &Error{code: "question_required"}
+	ErrAnswerRequired          = &Error{code: "answer_required"}
+	ErrQuestionAlreadyAnswered = &Error{code: "question_already_answered"}
+	ErrInvalidLocale           = &Error{code: "invalid_locale"}
+	ErrInvalidTimezone         = &Error{code: "invalid_timezone"}
+	ErrCalendarNotFound        = &Error{code: "calendar_not_found"}
+	ErrWebhookNotFound         = &Error{code: "webhook_not_found"}
+	ErrInvalidWebhookURL       = &Error{code: "invalid_webhook_url"}
 )
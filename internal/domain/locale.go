@@ -0,0 +1,10 @@
+package domain
+
+const (
+	LocaleFR = "fr"
+	LocaleEN = "en"
+)
+
+func IsSupportedLocale(locale string) bool {
+	return locale == LocaleFR || locale == LocaleEN
+}
@@ -0,0 +1,10 @@
+package domain
+
+const (
+	RecurrenceWeekly  = "WEEKLY"
+	RecurrenceMonthly = "MONTHLY"
+)
+
+func IsValidRecurrence(recurrence string) bool {
+	return recurrence == RecurrenceWeekly || recurrence == RecurrenceMonthly
+}
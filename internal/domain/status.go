@@ -3,4 +3,6 @@ package domain
 const (
 	StatusConfirmed = "CONFIRMED"
 	StatusWaitlist  = "WAITLIST"
+	// StatusOffered is a waitlist participant holding a freed slot until they accept it or the offer expires.
+	StatusOffered = "OFFERED"
 )
@@ -0,0 +1,27 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"servbot/internal/infrastructure/database/sqlc_generated"
+)
+
+// BackfillGuildID assigns guildID to the events and series saved before they carried their
+// guild (migration 000009), when the bot only served the guild set in GUILD_ID. Rows already
+// attached to a guild are left alone, so running it on every start is harmless.
+func BackfillGuildID(ctx context.Context, q *sqlc_generated.Queries, guildID string) error {
+	events, err := q.BackfillEventsGuildID(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("backfill events guild id: %w", err)
+	}
+	series, err := q.BackfillEventSeriesGuildID(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("backfill event series guild id: %w", err)
+	}
+	if events > 0 || series > 0 {
+		log.Printf("✅ Serveur %s attribué à %d événement(s) et %d série(s) existants", guildID, events, series)
+	}
+	return nil
+}
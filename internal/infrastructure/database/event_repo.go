@@ -7,6 +7,7 @@ import (
 
 	"github.com/jackc/pgx/v5/pgtype"
 
+	"servbot/internal/domain"
 	"servbot/internal/domain/entities"
 	"servbot/internal/infrastructure/database/sqlc_generated"
 	"servbot/internal/ports/output"
@@ -23,21 +24,29 @@ func NewEventRepository(pool *sqlc_generated.Queries) *EventRepository {
 }
 
 func (r *EventRepository) Create(ctx context.Context, event *entities.Event) error {
-	var scheduledAt pgtype.Timestamptz
-	if !event.ScheduledAt.IsZero() {
-		scheduledAt = pgtype.Timestamptz{Time: event.ScheduledAt, Valid: true}
+	var seriesID pgtype.Int8
+	if event.SeriesID != 0 {
+		seriesID = pgtype.Int8{Int64: int64(event.SeriesID), Valid: true}
 	}
 	row, err := r.q.CreateEvent(ctx, sqlc_generated.CreateEventParams{
+		GuildID:           event.GuildID,
 		MessageID:         event.MessageID,
 		ChannelID:         event.ChannelID,
 		CreatorID:         event.CreatorID,
 		Title:             event.Title,
 		Description:       event.Description,
+		Location:          event.Location,
 		MaxSlots:          int32(event.MaxSlots),
-		ScheduledAt:       scheduledAt,
+		MaxGuests:         int32(event.MaxGuests),
+		AllowedRoleIds:    roleIDsToPg(event.AllowedRoleIDs),
+		PriorityRoleIds:   roleIDsToPg(event.PriorityRoleIDs),
+		ScheduledAt:       timeToPgtypeTimestamptz(event.ScheduledAt),
+		EndsAt:            timeToPgtypeTimestamptz(event.EndsAt),
+		Timezone:          event.Timezone,
 		PrivateChannelID:  event.PrivateChannelID,
 		QuestionsThreadID: event.QuestionsThreadID,
 		WaitlistAuto:      event.WaitlistAuto,
+		SeriesID:          seriesID,
 	})
 	if err != nil {
 		return fmt.Errorf("create event: %w", err)
@@ -57,6 +66,9 @@ func (r *EventRepository) FindByMessageID(ctx context.Context, messageID string)
 	if err := r.attachParticipants(ctx, &e); err != nil {
 		return nil, err
 	}
+	if err := r.attachCoHosts(ctx, &e); err != nil {
+		return nil, err
+	}
 	return &e, nil
 }
 
@@ -69,6 +81,24 @@ func (r *EventRepository) FindByID(ctx context.Context, id uint) (*entities.Even
 	if err := r.attachParticipants(ctx, &e); err != nil {
 		return nil, err
 	}
+	if err := r.attachCoHosts(ctx, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *EventRepository) FindByIDForUpdate(ctx context.Context, id uint) (*entities.Event, error) {
+	row, err := r.q.GetEventByIDForUpdate(ctx, int64(id))
+	if err != nil {
+		return nil, fmt.Errorf("get event by id for update: %w", err)
+	}
+	e := eventToDomain(row)
+	if err := r.attachParticipants(ctx, &e); err != nil {
+		return nil, err
+	}
+	if err := r.attachCoHosts(ctx, &e); err != nil {
+		return nil, err
+	}
 	return &e, nil
 }
 
@@ -81,6 +111,18 @@ func (r *EventRepository) FindByPrivateChannelID(ctx context.Context, privateCha
 	if err := r.attachParticipants(ctx, &e); err != nil {
 		return nil, err
 	}
+	if err := r.attachCoHosts(ctx, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *EventRepository) FindByScheduledEventID(ctx context.Context, scheduledEventID string) (*entities.Event, error) {
+	row, err := r.q.GetEventByScheduledEventID(ctx, scheduledEventID)
+	if err != nil {
+		return nil, fmt.Errorf("get event by scheduled event id: %w", err)
+	}
+	e := eventToDomain(row)
 	return &e, nil
 }
 
@@ -96,8 +138,29 @@ func (r *EventRepository) attachParticipants(ctx context.Context, e *entities.Ev
 	return nil
 }
 
-func (r *EventRepository) FindByCreatorID(ctx context.Context, creatorID string) ([]entities.Event, error) {
-	rows, err := r.q.GetEventsByCreatorID(ctx, creatorID)
+func (r *EventRepository) attachCoHosts(ctx context.Context, e *entities.Event) error {
+	hostIDs, err := r.q.GetEventHostIDsByEventID(ctx, int64(e.ID))
+	if err != nil {
+		return fmt.Errorf("get event hosts: %w", err)
+	}
+	e.CoHostIDs = hostIDs
+	return nil
+}
+
+func (r *EventRepository) FindByGuildID(ctx context.Context, guildID string) ([]entities.Event, error) {
+	rows, err := r.q.GetEventsByGuildID(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("get events by guild id: %w", err)
+	}
+	out := make([]entities.Event, len(rows))
+	for i := range rows {
+		out[i] = eventToDomain(rows[i])
+	}
+	return out, nil
+}
+
+func (r *EventRepository) FindByCreatorID(ctx context.Context, guildID, creatorID string) ([]entities.Event, error) {
+	rows, err := r.q.GetEventsByCreatorID(ctx, sqlc_generated.GetEventsByCreatorIDParams{GuildID: guildID, CreatorID: creatorID})
 	if err != nil {
 		return nil, fmt.Errorf("get events by creator id: %w", err)
 	}
@@ -132,6 +195,33 @@ func (r *EventRepository) FindStartedNonFinalizedEvents(ctx context.Context, now
 	return out, nil
 }
 
+func (r *EventRepository) FindUpcomingByParticipant(ctx context.Context, userID string, now time.Time) ([]entities.Event, error) {
+	rows, err := r.q.GetUpcomingEventsByParticipant(ctx, sqlc_generated.GetUpcomingEventsByParticipantParams{
+		UserID: userID,
+		Now:    pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get upcoming events by participant: %w", err)
+	}
+	out := make([]entities.Event, len(rows))
+	for i := range rows {
+		out[i] = eventToDomain(rows[i])
+	}
+	return out, nil
+}
+
+func (r *EventRepository) FindRecentlyEndedEvents(ctx context.Context, now time.Time) ([]entities.Event, error) {
+	rows, err := r.q.FindRecentlyEndedEvents(ctx, pgtype.Timestamptz{Time: now, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("find recently ended events: %w", err)
+	}
+	out := make([]entities.Event, len(rows))
+	for i := range rows {
+		out[i] = eventToDomain(rows[i])
+	}
+	return out, nil
+}
+
 func (r *EventRepository) MarkOrganizerValidationDMSent(ctx context.Context, eventID uint) error {
 	if err := r.q.MarkOrganizerValidationDMSent(ctx, int64(eventID)); err != nil {
 		return fmt.Errorf("mark organizer validation DM sent: %w", err)
@@ -146,17 +236,61 @@ func (r *EventRepository) MarkOrganizerStep1Finalized(ctx context.Context, event
 	return nil
 }
 
-func (r *EventRepository) Update(ctx context.Context, event *entities.Event) error {
-	var scheduledAt pgtype.Timestamptz
-	if !event.ScheduledAt.IsZero() {
-		scheduledAt = pgtype.Timestamptz{Time: event.ScheduledAt, Valid: true}
+func (r *EventRepository) SetFAQMessageID(ctx context.Context, eventID uint, messageID string) error {
+	err := r.q.SetEventFAQMessageID(ctx, sqlc_generated.SetEventFAQMessageIDParams{
+		ID:           int64(eventID),
+		FaqMessageID: messageID,
+	})
+	if err != nil {
+		return fmt.Errorf("set event faq message id: %w", err)
+	}
+	return nil
+}
+
+func (r *EventRepository) SetScheduledEventID(ctx context.Context, eventID uint, scheduledEventID string) error {
+	err := r.q.SetEventScheduledEventID(ctx, sqlc_generated.SetEventScheduledEventIDParams{
+		ID:               int64(eventID),
+		ScheduledEventID: scheduledEventID,
+	})
+	if err != nil {
+		return fmt.Errorf("set event scheduled event id: %w", err)
+	}
+	return nil
+}
+
+func (r *EventRepository) UpdateSchedule(ctx context.Context, eventID uint, scheduledAt, endsAt time.Time) error {
+	err := r.q.UpdateEventSchedule(ctx, sqlc_generated.UpdateEventScheduleParams{
+		ID:          int64(eventID),
+		ScheduledAt: timeToPgtypeTimestamptz(scheduledAt),
+		EndsAt:      timeToPgtypeTimestamptz(endsAt),
+	})
+	if err != nil {
+		return fmt.Errorf("update event schedule: %w", err)
 	}
+	return nil
+}
+
+func (r *EventRepository) MarkCancelled(ctx context.Context, eventID uint, reason string) error {
+	err := r.q.CancelEvent(ctx, sqlc_generated.CancelEventParams{
+		ID:           int64(eventID),
+		CancelReason: reason,
+	})
+	if err != nil {
+		return fmt.Errorf("cancel event: %w", err)
+	}
+	return nil
+}
+
+func (r *EventRepository) Update(ctx context.Context, event *entities.Event) error {
 	err := r.q.UpdateEvent(ctx, sqlc_generated.UpdateEventParams{
 		ID:           int64(event.ID),
 		Title:        event.Title,
 		Description:  event.Description,
+		Location:     event.Location,
 		MaxSlots:     int32(event.MaxSlots),
-		ScheduledAt:  scheduledAt,
+		MaxGuests:    int32(event.MaxGuests),
+		ScheduledAt:  timeToPgtypeTimestamptz(event.ScheduledAt),
+		EndsAt:       timeToPgtypeTimestamptz(event.EndsAt),
 		WaitlistAuto: event.WaitlistAuto,
 	})
 	if err != nil {
@@ -165,9 +299,57 @@ func (r *EventRepository) Update(ctx context.Context, event *entities.Event) err
 	return nil
 }
 
+func (r *EventRepository) UpdateRoles(ctx context.Context, event *entities.Event) error {
+	err := r.q.UpdateEventRoles(ctx, sqlc_generated.UpdateEventRolesParams{
+		ID:              int64(event.ID),
+		AllowedRoleIds:  roleIDsToPg(event.AllowedRoleIDs),
+		PriorityRoleIds: roleIDsToPg(event.PriorityRoleIDs),
+	})
+	if err != nil {
+		return fmt.Errorf("update event roles: %w", err)
+	}
+	return nil
+}
+
+func (r *EventRepository) AddCoHost(ctx context.Context, eventID uint, userID, addedBy string) error {
+	n, err := r.q.AddEventHost(ctx, sqlc_generated.AddEventHostParams{
+		EventID: int64(eventID),
+		UserID:  userID,
+		AddedBy: addedBy,
+	})
+	if err != nil {
+		return fmt.Errorf("add event host: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrAlreadyCoHost
+	}
+	return nil
+}
+
+func (r *EventRepository) RemoveCoHost(ctx context.Context, eventID uint, userID string) error {
+	n, err := r.q.DeleteEventHost(ctx, sqlc_generated.DeleteEventHostParams{
+		EventID: int64(eventID),
+		UserID:  userID,
+	})
+	if err != nil {
+		return fmt.Errorf("delete event host: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrCoHostNotFound
+	}
+	return nil
+}
+
 func (r *EventRepository) Delete(ctx context.Context, id uint) error {
 	if err := r.q.DeleteEvent(ctx, int64(id)); err != nil {
 		return fmt.Errorf("delete event: %w", err)
 	}
 	return nil
 }
+
+func (r *EventRepository) DeleteByGuildID(ctx context.Context, guildID string) error {
+	if err := r.q.DeleteEventsByGuildID(ctx, guildID); err != nil {
+		return fmt.Errorf("delete events by guild id: %w", err)
+	}
+	return nil
+}
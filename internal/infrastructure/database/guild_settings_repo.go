@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"servbot/internal/domain/entities"
+	"servbot/internal/infrastructure/database/sqlc_generated"
+	"servbot/internal/ports/output"
+)
+
+var _ output.GuildSettingsRepository = (*GuildSettingsRepository)(nil)
+
+type GuildSettingsRepository struct {
+	q *sqlc_generated.Queries
+}
+
+func NewGuildSettingsRepository(q *sqlc_generated.Queries) *GuildSettingsRepository {
+	return &GuildSettingsRepository{q: q}
+}
+
+func (r *GuildSettingsRepository) FindByGuildID(ctx context.Context, guildID string) (*entities.GuildSettings, error) {
+	row, err := r.q.GetGuildSettings(ctx, guildID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get guild settings: %w", err)
+	}
+	g := guildSettingsToDomain(row)
+	return &g, nil
+}
+
+func (r *GuildSettingsRepository) Save(ctx context.Context, settings *entities.GuildSettings) error {
+	err := r.q.UpsertGuildSettings(ctx, sqlc_generated.UpsertGuildSettingsParams{
+		GuildID:           settings.GuildID,
+		ForumChannelID:    settings.ForumChannelID,
+		PrivateCategoryID: settings.PrivateCategoryID,
+		Locale:            settings.Locale,
+		Timezone:          settings.Timezone,
+		WaitlistAuto:      settings.WaitlistAuto,
+		OfferDelayHours:   int32(settings.OfferDelayHours),
+	})
+	if err != nil {
+		return fmt.Errorf("upsert guild settings: %w", err)
+	}
+	return nil
+}
+
+func (r *GuildSettingsRepository) Delete(ctx context.Context, guildID string) error {
+	if err := r.q.DeleteGuildSettings(ctx, guildID); err != nil {
+		return fmt.Errorf("delete guild settings: %w", err)
+	}
+	return nil
+}
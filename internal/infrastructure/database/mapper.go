@@ -17,35 +17,141 @@ func pgtypeTimestamptzToTime(t pgtype.Timestamptz) time.Time {
 	return t.Time
 }
 
+// timeToPgtypeTimestamptz maps the zero time to NULL.
+func timeToPgtypeTimestamptz(t time.Time) pgtype.Timestamptz {
+	if t.IsZero() {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: t, Valid: true}
+}
+
+// roleIDsToPg maps nil to an empty array, the columns being NOT NULL.
+func roleIDsToPg(ids []string) []string {
+	if ids == nil {
+		return []string{}
+	}
+	return ids
+}
+
 func eventToDomain(e sqlc_generated.Event) entities.Event {
 	return entities.Event{
 		ID:                          uint(e.ID),
+		GuildID:                     e.GuildID,
 		MessageID:                   e.MessageID,
 		ChannelID:                   e.ChannelID,
 		CreatorID:                   e.CreatorID,
 		Title:                       e.Title,
 		Description:                 e.Description,
+		Location:                    e.Location,
 		MaxSlots:                    int(e.MaxSlots),
+		MaxGuests:                   int(e.MaxGuests),
+		AllowedRoleIDs:              e.AllowedRoleIds,
+		PriorityRoleIDs:             e.PriorityRoleIds,
 		ScheduledAt:                 pgtypeTimestamptzToTime(e.ScheduledAt),
+		EndsAt:                      pgtypeTimestamptzToTime(e.EndsAt),
+		Timezone:                    e.Timezone,
 		PrivateChannelID:            e.PrivateChannelID,
 		QuestionsThreadID:           e.QuestionsThreadID,
+		FAQMessageID:                e.FaqMessageID,
+		ScheduledEventID:            e.ScheduledEventID,
 		WaitlistAuto:                e.WaitlistAuto,
+		SeriesID:                    uint(e.SeriesID.Int64),
 		OrganizerValidationDMSentAt: pgtypeTimestamptzToTime(e.OrganizerValidationDmSentAt),
 		OrganizerStep1FinalizedAt:   pgtypeTimestamptzToTime(e.OrganizerStep1FinalizedAt),
+		CancelledAt:                 pgtypeTimestamptzToTime(e.CancelledAt),
+		CancelReason:                e.CancelReason,
 		CreatedAt:                   pgtypeTimestamptzToTime(e.CreatedAt),
 		UpdatedAt:                   pgtypeTimestamptzToTime(e.UpdatedAt),
 	}
 }
 
+func seriesToDomain(s sqlc_generated.EventSeries) entities.Series {
+	return entities.Series{
+		ID:              uint(s.ID),
+		GuildID:         s.GuildID,
+		CreatorID:       s.CreatorID,
+		Recurrence:      s.Recurrence,
+		Title:           s.Title,
+		Description:     s.Description,
+		Location:        s.Location,
+		MaxSlots:        int(s.MaxSlots),
+		MaxGuests:       int(s.MaxGuests),
+		AllowedRoleIDs:  s.AllowedRoleIds,
+		PriorityRoleIDs: s.PriorityRoleIds,
+		WaitlistAuto:    s.WaitlistAuto,
+		NextAt:          pgtypeTimestamptzToTime(s.NextAt),
+		Duration:        time.Duration(s.DurationMinutes) * time.Minute,
+		Timezone:        s.Timezone,
+		AnchorDay:       int(s.AnchorDay),
+		CancelledAt:     pgtypeTimestamptzToTime(s.CancelledAt),
+		CreatedAt:       pgtypeTimestamptzToTime(s.CreatedAt),
+		UpdatedAt:       pgtypeTimestamptzToTime(s.UpdatedAt),
+	}
+}
+
+func questionToDomain(q sqlc_generated.Question) entities.Question {
+	return entities.Question{
+		ID:              uint(q.ID),
+		EventID:         uint(q.EventID),
+		AskerID:         q.AskerID,
+		AskerName:       q.AskerName,
+		Text:            q.Question,
+		ThreadMessageID: q.ThreadMessageID,
+		Answer:          q.Answer,
+		AnswererID:      q.AnswererID,
+		AnsweredAt:      pgtypeTimestamptzToTime(q.AnsweredAt),
+		PublishedAt:     pgtypeTimestamptzToTime(q.PublishedAt),
+		CreatedAt:       pgtypeTimestamptzToTime(q.CreatedAt),
+		UpdatedAt:       pgtypeTimestamptzToTime(q.UpdatedAt),
+	}
+}
+
+func guildSettingsToDomain(g sqlc_generated.GuildSetting) entities.GuildSettings {
+	return entities.GuildSettings{
+		GuildID:           g.GuildID,
+		ForumChannelID:    g.ForumChannelID,
+		PrivateCategoryID: g.PrivateCategoryID,
+		Locale:            g.Locale,
+		Timezone:          g.Timezone,
+		WaitlistAuto:      g.WaitlistAuto,
+		OfferDelayHours:   int(g.OfferDelayHours),
+		CreatedAt:         pgtypeTimestamptzToTime(g.CreatedAt),
+		UpdatedAt:         pgtypeTimestamptzToTime(g.UpdatedAt),
+	}
+}
+
+func userPreferencesToDomain(p sqlc_generated.UserPreference) entities.UserPreferences {
+	return entities.UserPreferences{
+		UserID:        p.UserID,
+		Locale:        p.Locale,
+		CalendarToken: p.CalendarToken,
+		CreatedAt:     pgtypeTimestamptzToTime(p.CreatedAt),
+		UpdatedAt:     pgtypeTimestamptzToTime(p.UpdatedAt),
+	}
+}
+
 func participantToDomain(p sqlc_generated.Participant) entities.Participant {
 	return entities.Participant{
-		ID:        uint(p.ID),
-		EventID:   uint(p.EventID),
-		UserID:    p.UserID,
-		Username:  p.Username,
-		Status:    p.Status,
-		JoinedAt:  pgtypeTimestamptzToTime(p.JoinedAt),
-		CreatedAt: pgtypeTimestamptzToTime(p.CreatedAt),
-		UpdatedAt: pgtypeTimestamptzToTime(p.UpdatedAt),
+		ID:             uint(p.ID),
+		EventID:        uint(p.EventID),
+		UserID:         p.UserID,
+		Username:       p.Username,
+		Status:         p.Status,
+		Guests:         int(p.Guests),
+		Priority:       p.Priority,
+		JoinedAt:       pgtypeTimestamptzToTime(p.JoinedAt),
+		OfferExpiresAt: pgtypeTimestamptzToTime(p.OfferExpiresAt),
+		CreatedAt:      pgtypeTimestamptzToTime(p.CreatedAt),
+		UpdatedAt:      pgtypeTimestamptzToTime(p.UpdatedAt),
+	}
+}
+
+func webhookSubscriptionToDomain(w sqlc_generated.WebhookSubscription) entities.WebhookSubscription {
+	return entities.WebhookSubscription{
+		ID:        uint(w.ID),
+		GuildID:   w.GuildID,
+		URL:       w.Url,
+		Secret:    w.Secret,
+		CreatedAt: pgtypeTimestamptzToTime(w.CreatedAt),
 	}
 }
@@ -2,10 +2,15 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 
+	"servbot/internal/domain"
 	"servbot/internal/domain/entities"
 	"servbot/internal/infrastructure/database/sqlc_generated"
 	"servbot/internal/ports/output"
@@ -27,8 +32,14 @@ func (r *ParticipantRepository) Create(ctx context.Context, participant *entitie
 		UserID:   participant.UserID,
 		Username: participant.Username,
 		Status:   participant.Status,
+		Guests:   int32(participant.Guests),
+		Priority: participant.Priority,
 		JoinedAt: pgtype.Timestamptz{Time: participant.JoinedAt, Valid: true},
 	})
+	// ON CONFLICT DO NOTHING returns no row when the user is already registered.
+	if errors.Is(err, pgx.ErrNoRows) || isUniqueViolation(err) {
+		return domain.ErrParticipantExists
+	}
 	if err != nil {
 		return fmt.Errorf("create participant: %w", err)
 	}
@@ -88,9 +99,11 @@ func (r *ParticipantRepository) FindByEventIDAndStatus(ctx context.Context, even
 
 func (r *ParticipantRepository) Update(ctx context.Context, participant *entities.Participant) error {
 	err := r.q.UpdateParticipant(ctx, sqlc_generated.UpdateParticipantParams{
-		ID:       int64(participant.ID),
-		Username: participant.Username,
-		Status:   participant.Status,
+		ID:             int64(participant.ID),
+		Username:       participant.Username,
+		Status:         participant.Status,
+		OfferExpiresAt: timeToPgtypeTimestamptz(participant.OfferExpiresAt),
+		Guests:         int32(participant.Guests),
 	})
 	if err != nil {
 		return fmt.Errorf("update participant: %w", err)
@@ -98,6 +111,47 @@ func (r *ParticipantRepository) Update(ctx context.Context, participant *entitie
 	return nil
 }
 
+func (r *ParticipantRepository) FindUpcomingByUserID(ctx context.Context, guildID, userID string, now time.Time) ([]entities.Participant, error) {
+	rows, err := r.q.GetUpcomingParticipationsByUserID(ctx, sqlc_generated.GetUpcomingParticipationsByUserIDParams{
+		UserID:  userID,
+		GuildID: guildID,
+		Now:     pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get upcoming participations by user id: %w", err)
+	}
+	out := make([]entities.Participant, len(rows))
+	for i := range rows {
+		out[i] = participantToDomain(rows[i])
+	}
+	return out, nil
+}
+
+func (r *ParticipantRepository) WaitlistRank(ctx context.Context, participant *entities.Participant) (int, error) {
+	rank, err := r.q.GetWaitlistRank(ctx, sqlc_generated.GetWaitlistRankParams{
+		EventID:  int64(participant.EventID),
+		Priority: participant.Priority,
+		JoinedAt: pgtype.Timestamptz{Time: participant.JoinedAt, Valid: true},
+		ID:       int64(participant.ID),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("get waitlist rank: %w", err)
+	}
+	return int(rank), nil
+}
+
+func (r *ParticipantRepository) FindExpiredOffers(ctx context.Context, now time.Time) ([]entities.Participant, error) {
+	rows, err := r.q.GetExpiredSlotOffers(ctx, pgtype.Timestamptz{Time: now, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("get expired slot offers: %w", err)
+	}
+	out := make([]entities.Participant, len(rows))
+	for i := range rows {
+		out[i] = participantToDomain(rows[i])
+	}
+	return out, nil
+}
+
 func (r *ParticipantRepository) Delete(ctx context.Context, participant *entities.Participant) error {
 	if err := r.q.DeleteParticipant(ctx, int64(participant.ID)); err != nil {
 		return fmt.Errorf("delete participant: %w", err)
@@ -105,6 +159,18 @@ func (r *ParticipantRepository) Delete(ctx context.Context, participant *entitie
 	return nil
 }
 
+func (r *ParticipantRepository) FindWaitlistByEventID(ctx context.Context, eventID uint) ([]entities.Participant, error) {
+	rows, err := r.q.GetWaitlistByEventID(ctx, int64(eventID))
+	if err != nil {
+		return nil, fmt.Errorf("get waitlist by event id: %w", err)
+	}
+	out := make([]entities.Participant, len(rows))
+	for i := range rows {
+		out[i] = participantToDomain(rows[i])
+	}
+	return out, nil
+}
+
 func (r *ParticipantRepository) CountByEventIDAndStatus(ctx context.Context, eventID uint, status string) (int64, error) {
 	count, err := r.q.CountParticipantsByEventIDAndStatus(ctx, sqlc_generated.CountParticipantsByEventIDAndStatusParams{
 		EventID: int64(eventID),
@@ -115,3 +181,21 @@ func (r *ParticipantRepository) CountByEventIDAndStatus(ctx context.Context, eve
 	}
 	return count, nil
 }
+
+// CountSeatsByEventIDAndStatus sums the seats, participant and guests, of the participants with status.
+func (r *ParticipantRepository) CountSeatsByEventIDAndStatus(ctx context.Context, eventID uint, status string) (int64, error) {
+	seats, err := r.q.CountSeatsByEventIDAndStatus(ctx, sqlc_generated.CountSeatsByEventIDAndStatusParams{
+		EventID: int64(eventID),
+		Status:  status,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("count seats: %w", err)
+	}
+	return seats, nil
+}
+
+// isUniqueViolation reports whether err is a PostgreSQL unique_violation (23505).
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+	"servbot/internal/infrastructure/database/sqlc_generated"
+	"servbot/internal/ports/output"
+)
+
+var _ output.QuestionRepository = (*QuestionRepository)(nil)
+
+type QuestionRepository struct {
+	q *sqlc_generated.Queries
+}
+
+func NewQuestionRepository(q *sqlc_generated.Queries) *QuestionRepository {
+	return &QuestionRepository{q: q}
+}
+
+func (r *QuestionRepository) Create(ctx context.Context, question *entities.Question) error {
+	row, err := r.q.CreateQuestion(ctx, sqlc_generated.CreateQuestionParams{
+		EventID:   int64(question.EventID),
+		AskerID:   question.AskerID,
+		AskerName: question.AskerName,
+		Question:  question.Text,
+	})
+	if err != nil {
+		return fmt.Errorf("create question: %w", err)
+	}
+	question.ID = uint(row.ID)
+	question.CreatedAt = pgtypeTimestamptzToTime(row.CreatedAt)
+	question.UpdatedAt = pgtypeTimestamptzToTime(row.UpdatedAt)
+	return nil
+}
+
+func (r *QuestionRepository) FindByID(ctx context.Context, id uint) (*entities.Question, error) {
+	row, err := r.q.GetQuestionByID(ctx, int64(id))
+	if err != nil {
+		return nil, fmt.Errorf("get question by id: %w", err)
+	}
+	q := questionToDomain(row)
+	return &q, nil
+}
+
+func (r *QuestionRepository) FindByEventID(ctx context.Context, eventID uint) ([]entities.Question, error) {
+	rows, err := r.q.GetQuestionsByEventID(ctx, int64(eventID))
+	if err != nil {
+		return nil, fmt.Errorf("get questions by event id: %w", err)
+	}
+	return questionsToDomain(rows), nil
+}
+
+func (r *QuestionRepository) FindUnansweredByEventID(ctx context.Context, eventID uint) ([]entities.Question, error) {
+	rows, err := r.q.GetUnansweredQuestionsByEventID(ctx, int64(eventID))
+	if err != nil {
+		return nil, fmt.Errorf("get unanswered questions by event id: %w", err)
+	}
+	return questionsToDomain(rows), nil
+}
+
+func (r *QuestionRepository) FindPublishedByEventID(ctx context.Context, eventID uint) ([]entities.Question, error) {
+	rows, err := r.q.GetPublishedQuestionsByEventID(ctx, int64(eventID))
+	if err != nil {
+		return nil, fmt.Errorf("get published questions by event id: %w", err)
+	}
+	return questionsToDomain(rows), nil
+}
+
+func (r *QuestionRepository) SetThreadMessageID(ctx context.Context, id uint, messageID string) error {
+	err := r.q.SetQuestionThreadMessageID(ctx, sqlc_generated.SetQuestionThreadMessageIDParams{
+		ID:              int64(id),
+		ThreadMessageID: messageID,
+	})
+	if err != nil {
+		return fmt.Errorf("set question thread message id: %w", err)
+	}
+	return nil
+}
+
+func (r *QuestionRepository) Answer(ctx context.Context, question *entities.Question, publish bool) error {
+	n, err := r.q.AnswerQuestion(ctx, sqlc_generated.AnswerQuestionParams{
+		ID:         int64(question.ID),
+		Answer:     question.Answer,
+		AnswererID: question.AnswererID,
+		Publish:    publish,
+	})
+	if err != nil {
+		return fmt.Errorf("answer question: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrQuestionAlreadyAnswered
+	}
+	return nil
+}
+
+func questionsToDomain(rows []sqlc_generated.Question) []entities.Question {
+	out := make([]entities.Question, len(rows))
+	for i := range rows {
+		out[i] = questionToDomain(rows[i])
+	}
+	return out
+}
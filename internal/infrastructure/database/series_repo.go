@@ -0,0 +1,132 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+	"servbot/internal/infrastructure/database/sqlc_generated"
+	"servbot/internal/ports/output"
+)
+
+var _ output.SeriesRepository = (*SeriesRepository)(nil)
+
+type SeriesRepository struct {
+	q *sqlc_generated.Queries
+}
+
+func NewSeriesRepository(q *sqlc_generated.Queries) *SeriesRepository {
+	return &SeriesRepository{q: q}
+}
+
+func (r *SeriesRepository) Create(ctx context.Context, series *entities.Series) error {
+	row, err := r.q.CreateEventSeries(ctx, sqlc_generated.CreateEventSeriesParams{
+		GuildID:         series.GuildID,
+		CreatorID:       series.CreatorID,
+		Recurrence:      series.Recurrence,
+		Title:           series.Title,
+		Description:     series.Description,
+		Location:        series.Location,
+		MaxSlots:        int32(series.MaxSlots),
+		MaxGuests:       int32(series.MaxGuests),
+		AllowedRoleIds:  roleIDsToPg(series.AllowedRoleIDs),
+		PriorityRoleIds: roleIDsToPg(series.PriorityRoleIDs),
+		WaitlistAuto:    series.WaitlistAuto,
+		NextAt:          pgtype.Timestamptz{Time: series.NextAt, Valid: true},
+		DurationMinutes: int32(series.Duration / time.Minute),
+		Timezone:        series.Timezone,
+		AnchorDay:       int32(series.AnchorDay),
+	})
+	if err != nil {
+		return fmt.Errorf("create event series: %w", err)
+	}
+	series.ID = uint(row.ID)
+	series.CreatedAt = pgtypeTimestamptzToTime(row.CreatedAt)
+	series.UpdatedAt = pgtypeTimestamptzToTime(row.UpdatedAt)
+	return nil
+}
+
+func (r *SeriesRepository) FindByID(ctx context.Context, id uint) (*entities.Series, error) {
+	row, err := r.q.GetEventSeriesByID(ctx, int64(id))
+	if err != nil {
+		return nil, fmt.Errorf("get event series by id: %w", err)
+	}
+	s := seriesToDomain(row)
+	return &s, nil
+}
+
+func (r *SeriesRepository) FindNeedingNextOccurrence(ctx context.Context, now time.Time) ([]entities.Series, error) {
+	rows, err := r.q.FindEventSeriesNeedingNextOccurrence(ctx, pgtype.Timestamptz{Time: now, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("find event series needing next occurrence: %w", err)
+	}
+	out := make([]entities.Series, len(rows))
+	for i := range rows {
+		out[i] = seriesToDomain(rows[i])
+	}
+	return out, nil
+}
+
+func (r *SeriesRepository) Update(ctx context.Context, series *entities.Series) error {
+	err := r.q.UpdateEventSeries(ctx, sqlc_generated.UpdateEventSeriesParams{
+		ID:              int64(series.ID),
+		Title:           series.Title,
+		Description:     series.Description,
+		Location:        series.Location,
+		MaxSlots:        int32(series.MaxSlots),
+		MaxGuests:       int32(series.MaxGuests),
+		WaitlistAuto:    series.WaitlistAuto,
+		NextAt:          pgtype.Timestamptz{Time: series.NextAt, Valid: true},
+		DurationMinutes: int32(series.Duration / time.Minute),
+		AnchorDay:       int32(series.AnchorDay),
+	})
+	if err != nil {
+		return fmt.Errorf("update event series: %w", err)
+	}
+	return nil
+}
+
+func (r *SeriesRepository) Advance(ctx context.Context, series *entities.Series, previous time.Time) error {
+	n, err := r.q.AdvanceEventSeries(ctx, sqlc_generated.AdvanceEventSeriesParams{
+		ID:             int64(series.ID),
+		NextAt:         pgtype.Timestamptz{Time: series.NextAt, Valid: true},
+		PreviousNextAt: pgtype.Timestamptz{Time: previous, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("advance event series: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrSeriesAlreadyAdvanced
+	}
+	return nil
+}
+
+func (r *SeriesRepository) UpdateRoles(ctx context.Context, series *entities.Series) error {
+	err := r.q.UpdateEventSeriesRoles(ctx, sqlc_generated.UpdateEventSeriesRolesParams{
+		ID:              int64(series.ID),
+		AllowedRoleIds:  roleIDsToPg(series.AllowedRoleIDs),
+		PriorityRoleIds: roleIDsToPg(series.PriorityRoleIDs),
+	})
+	if err != nil {
+		return fmt.Errorf("update event series roles: %w", err)
+	}
+	return nil
+}
+
+func (r *SeriesRepository) Cancel(ctx context.Context, id uint) error {
+	if err := r.q.CancelEventSeries(ctx, int64(id)); err != nil {
+		return fmt.Errorf("cancel event series: %w", err)
+	}
+	return nil
+}
+
+func (r *SeriesRepository) DeleteByGuildID(ctx context.Context, guildID string) error {
+	if err := r.q.DeleteEventSeriesByGuildID(ctx, guildID); err != nil {
+		return fmt.Errorf("delete event series by guild id: %w", err)
+	}
+	return nil
+}
@@ -11,55 +11,109 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const backfillEventsGuildID = `-- name: BackfillEventsGuildID :execrows
+UPDATE events SET guild_id = $1 WHERE guild_id = ''
+`
+
+func (q *Queries) BackfillEventsGuildID(ctx context.Context, guildID string) (int64, error) {
+	result, err := q.db.Exec(ctx, backfillEventsGuildID, guildID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const cancelEvent = `-- name: CancelEvent :exec
+UPDATE events SET cancelled_at = NOW(), cancel_reason = $2, updated_at = NOW() WHERE id = $1
+`
+
+type CancelEventParams struct {
+	ID           int64
+	CancelReason string
+}
+
+func (q *Queries) CancelEvent(ctx context.Context, arg CancelEventParams) error {
+	_, err := q.db.Exec(ctx, cancelEvent, arg.ID, arg.CancelReason)
+	return err
+}
+
 const createEvent = `-- name: CreateEvent :one
-INSERT INTO events (message_id, channel_id, creator_id, title, description, max_slots, scheduled_at, private_channel_id, questions_thread_id, waitlist_auto)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-RETURNING id, message_id, channel_id, creator_id, title, description, max_slots, scheduled_at, private_channel_id, questions_thread_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, created_at, updated_at
+INSERT INTO events (guild_id, message_id, channel_id, creator_id, title, description, location, max_slots, max_guests, allowed_role_ids, priority_role_ids, scheduled_at, ends_at, timezone, private_channel_id, questions_thread_id, waitlist_auto, series_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+RETURNING id, guild_id, message_id, channel_id, creator_id, title, description, location, max_slots, max_guests, allowed_role_ids, priority_role_ids, scheduled_at, ends_at, timezone, private_channel_id, questions_thread_id, faq_message_id, scheduled_event_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, cancelled_at, cancel_reason, created_at, updated_at, series_id
 `
 
 type CreateEventParams struct {
+	GuildID           string
 	MessageID         string
 	ChannelID         string
 	CreatorID         string
 	Title             string
 	Description       string
+	Location          string
 	MaxSlots          int32
+	MaxGuests         int32
+	AllowedRoleIds    []string
+	PriorityRoleIds   []string
 	ScheduledAt       pgtype.Timestamptz
+	EndsAt            pgtype.Timestamptz
+	Timezone          string
 	PrivateChannelID  string
 	QuestionsThreadID string
 	WaitlistAuto      bool
+	SeriesID          pgtype.Int8
 }
 
 func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (Event, error) {
 	row := q.db.QueryRow(ctx, createEvent,
+		arg.GuildID,
 		arg.MessageID,
 		arg.ChannelID,
 		arg.CreatorID,
 		arg.Title,
 		arg.Description,
+		arg.Location,
 		arg.MaxSlots,
+		arg.MaxGuests,
+		arg.AllowedRoleIds,
+		arg.PriorityRoleIds,
 		arg.ScheduledAt,
+		arg.EndsAt,
+		arg.Timezone,
 		arg.PrivateChannelID,
 		arg.QuestionsThreadID,
 		arg.WaitlistAuto,
+		arg.SeriesID,
 	)
 	var i Event
 	err := row.Scan(
 		&i.ID,
+		&i.GuildID,
 		&i.MessageID,
 		&i.ChannelID,
 		&i.CreatorID,
 		&i.Title,
 		&i.Description,
+		&i.Location,
 		&i.MaxSlots,
+		&i.MaxGuests,
+		&i.AllowedRoleIds,
+		&i.PriorityRoleIds,
 		&i.ScheduledAt,
+		&i.EndsAt,
+		&i.Timezone,
 		&i.PrivateChannelID,
 		&i.QuestionsThreadID,
+		&i.FaqMessageID,
+		&i.ScheduledEventID,
 		&i.WaitlistAuto,
 		&i.OrganizerValidationDmSentAt,
 		&i.OrganizerStep1FinalizedAt,
+		&i.CancelledAt,
+		&i.CancelReason,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SeriesID,
 	)
 	return i, err
 }
@@ -73,13 +127,23 @@ func (q *Queries) DeleteEvent(ctx context.Context, id int64) error {
 	return err
 }
 
+const deleteEventsByGuildID = `-- name: DeleteEventsByGuildID :exec
+DELETE FROM events WHERE guild_id = $1
+`
+
+func (q *Queries) DeleteEventsByGuildID(ctx context.Context, guildID string) error {
+	_, err := q.db.Exec(ctx, deleteEventsByGuildID, guildID)
+	return err
+}
+
 const findEventsNeedingH48OrganizerDM = `-- name: FindEventsNeedingH48OrganizerDM :many
-SELECT id, message_id, channel_id, creator_id, title, description, max_slots, scheduled_at, private_channel_id, questions_thread_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, created_at, updated_at FROM events
+SELECT id, guild_id, message_id, channel_id, creator_id, title, description, location, max_slots, max_guests, allowed_role_ids, priority_role_ids, scheduled_at, ends_at, timezone, private_channel_id, questions_thread_id, faq_message_id, scheduled_event_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, cancelled_at, cancel_reason, created_at, updated_at, series_id FROM events
 WHERE scheduled_at IS NOT NULL
   AND scheduled_at > $1
   AND scheduled_at - interval '48 hours' <= $1
   AND scheduled_at - interval '47 hours' > $1
   AND organizer_validation_dm_sent_at IS NULL
+  AND cancelled_at IS NULL
 `
 
 func (q *Queries) FindEventsNeedingH48OrganizerDM(ctx context.Context, scheduledAt pgtype.Timestamptz) ([]Event, error) {
@@ -93,20 +157,88 @@ func (q *Queries) FindEventsNeedingH48OrganizerDM(ctx context.Context, scheduled
 		var i Event
 		if err := rows.Scan(
 			&i.ID,
+			&i.GuildID,
+			&i.MessageID,
+			&i.ChannelID,
+			&i.CreatorID,
+			&i.Title,
+			&i.Description,
+			&i.Location,
+			&i.MaxSlots,
+			&i.MaxGuests,
+			&i.AllowedRoleIds,
+			&i.PriorityRoleIds,
+			&i.ScheduledAt,
+			&i.EndsAt,
+			&i.Timezone,
+			&i.PrivateChannelID,
+			&i.QuestionsThreadID,
+			&i.FaqMessageID,
+			&i.ScheduledEventID,
+			&i.WaitlistAuto,
+			&i.OrganizerValidationDmSentAt,
+			&i.OrganizerStep1FinalizedAt,
+			&i.CancelledAt,
+			&i.CancelReason,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SeriesID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findRecentlyEndedEvents = `-- name: FindRecentlyEndedEvents :many
+SELECT id, guild_id, message_id, channel_id, creator_id, title, description, location, max_slots, max_guests, allowed_role_ids, priority_role_ids, scheduled_at, ends_at, timezone, private_channel_id, questions_thread_id, faq_message_id, scheduled_event_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, cancelled_at, cancel_reason, created_at, updated_at, series_id FROM events
+WHERE scheduled_at IS NOT NULL
+  AND COALESCE(ends_at, scheduled_at + interval '2 hours') <= $1::timestamptz
+  AND COALESCE(ends_at, scheduled_at + interval '2 hours') > $1::timestamptz - interval '1 hour'
+  AND cancelled_at IS NULL
+`
+
+func (q *Queries) FindRecentlyEndedEvents(ctx context.Context, now pgtype.Timestamptz) ([]Event, error) {
+	rows, err := q.db.Query(ctx, findRecentlyEndedEvents, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.GuildID,
 			&i.MessageID,
 			&i.ChannelID,
 			&i.CreatorID,
 			&i.Title,
 			&i.Description,
+			&i.Location,
 			&i.MaxSlots,
+			&i.MaxGuests,
+			&i.AllowedRoleIds,
+			&i.PriorityRoleIds,
 			&i.ScheduledAt,
+			&i.EndsAt,
+			&i.Timezone,
 			&i.PrivateChannelID,
 			&i.QuestionsThreadID,
+			&i.FaqMessageID,
+			&i.ScheduledEventID,
 			&i.WaitlistAuto,
 			&i.OrganizerValidationDmSentAt,
 			&i.OrganizerStep1FinalizedAt,
+			&i.CancelledAt,
+			&i.CancelReason,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SeriesID,
 		); err != nil {
 			return nil, err
 		}
@@ -119,11 +251,12 @@ func (q *Queries) FindEventsNeedingH48OrganizerDM(ctx context.Context, scheduled
 }
 
 const findStartedNonFinalizedEvents = `-- name: FindStartedNonFinalizedEvents :many
-SELECT id, message_id, channel_id, creator_id, title, description, max_slots, scheduled_at, private_channel_id, questions_thread_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, created_at, updated_at FROM events
+SELECT id, guild_id, message_id, channel_id, creator_id, title, description, location, max_slots, max_guests, allowed_role_ids, priority_role_ids, scheduled_at, ends_at, timezone, private_channel_id, questions_thread_id, faq_message_id, scheduled_event_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, cancelled_at, cancel_reason, created_at, updated_at, series_id FROM events
 WHERE scheduled_at IS NOT NULL
   AND scheduled_at <= $1
   AND scheduled_at > $1 - interval '1 hour'
   AND organizer_step1_finalized_at IS NULL
+  AND cancelled_at IS NULL
 `
 
 func (q *Queries) FindStartedNonFinalizedEvents(ctx context.Context, scheduledAt pgtype.Timestamptz) ([]Event, error) {
@@ -137,20 +270,32 @@ func (q *Queries) FindStartedNonFinalizedEvents(ctx context.Context, scheduledAt
 		var i Event
 		if err := rows.Scan(
 			&i.ID,
+			&i.GuildID,
 			&i.MessageID,
 			&i.ChannelID,
 			&i.CreatorID,
 			&i.Title,
 			&i.Description,
+			&i.Location,
 			&i.MaxSlots,
+			&i.MaxGuests,
+			&i.AllowedRoleIds,
+			&i.PriorityRoleIds,
 			&i.ScheduledAt,
+			&i.EndsAt,
+			&i.Timezone,
 			&i.PrivateChannelID,
 			&i.QuestionsThreadID,
+			&i.FaqMessageID,
+			&i.ScheduledEventID,
 			&i.WaitlistAuto,
 			&i.OrganizerValidationDmSentAt,
 			&i.OrganizerStep1FinalizedAt,
+			&i.CancelledAt,
+			&i.CancelReason,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SeriesID,
 		); err != nil {
 			return nil, err
 		}
@@ -163,7 +308,7 @@ func (q *Queries) FindStartedNonFinalizedEvents(ctx context.Context, scheduledAt
 }
 
 const getEventByID = `-- name: GetEventByID :one
-SELECT id, message_id, channel_id, creator_id, title, description, max_slots, scheduled_at, private_channel_id, questions_thread_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, created_at, updated_at FROM events WHERE id = $1
+SELECT id, guild_id, message_id, channel_id, creator_id, title, description, location, max_slots, max_guests, allowed_role_ids, priority_role_ids, scheduled_at, ends_at, timezone, private_channel_id, questions_thread_id, faq_message_id, scheduled_event_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, cancelled_at, cancel_reason, created_at, updated_at, series_id FROM events WHERE id = $1
 `
 
 func (q *Queries) GetEventByID(ctx context.Context, id int64) (Event, error) {
@@ -171,26 +316,77 @@ func (q *Queries) GetEventByID(ctx context.Context, id int64) (Event, error) {
 	var i Event
 	err := row.Scan(
 		&i.ID,
+		&i.GuildID,
+		&i.MessageID,
+		&i.ChannelID,
+		&i.CreatorID,
+		&i.Title,
+		&i.Description,
+		&i.Location,
+		&i.MaxSlots,
+		&i.MaxGuests,
+		&i.AllowedRoleIds,
+		&i.PriorityRoleIds,
+		&i.ScheduledAt,
+		&i.EndsAt,
+		&i.Timezone,
+		&i.PrivateChannelID,
+		&i.QuestionsThreadID,
+		&i.FaqMessageID,
+		&i.ScheduledEventID,
+		&i.WaitlistAuto,
+		&i.OrganizerValidationDmSentAt,
+		&i.OrganizerStep1FinalizedAt,
+		&i.CancelledAt,
+		&i.CancelReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SeriesID,
+	)
+	return i, err
+}
+
+const getEventByIDForUpdate = `-- name: GetEventByIDForUpdate :one
+SELECT id, guild_id, message_id, channel_id, creator_id, title, description, location, max_slots, max_guests, allowed_role_ids, priority_role_ids, scheduled_at, ends_at, timezone, private_channel_id, questions_thread_id, faq_message_id, scheduled_event_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, cancelled_at, cancel_reason, created_at, updated_at, series_id FROM events WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) GetEventByIDForUpdate(ctx context.Context, id int64) (Event, error) {
+	row := q.db.QueryRow(ctx, getEventByIDForUpdate, id)
+	var i Event
+	err := row.Scan(
+		&i.ID,
+		&i.GuildID,
 		&i.MessageID,
 		&i.ChannelID,
 		&i.CreatorID,
 		&i.Title,
 		&i.Description,
+		&i.Location,
 		&i.MaxSlots,
+		&i.MaxGuests,
+		&i.AllowedRoleIds,
+		&i.PriorityRoleIds,
 		&i.ScheduledAt,
+		&i.EndsAt,
+		&i.Timezone,
 		&i.PrivateChannelID,
 		&i.QuestionsThreadID,
+		&i.FaqMessageID,
+		&i.ScheduledEventID,
 		&i.WaitlistAuto,
 		&i.OrganizerValidationDmSentAt,
 		&i.OrganizerStep1FinalizedAt,
+		&i.CancelledAt,
+		&i.CancelReason,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SeriesID,
 	)
 	return i, err
 }
 
 const getEventByMessageID = `-- name: GetEventByMessageID :one
-SELECT id, message_id, channel_id, creator_id, title, description, max_slots, scheduled_at, private_channel_id, questions_thread_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, created_at, updated_at FROM events WHERE message_id = $1
+SELECT id, guild_id, message_id, channel_id, creator_id, title, description, location, max_slots, max_guests, allowed_role_ids, priority_role_ids, scheduled_at, ends_at, timezone, private_channel_id, questions_thread_id, faq_message_id, scheduled_event_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, cancelled_at, cancel_reason, created_at, updated_at, series_id FROM events WHERE message_id = $1
 `
 
 func (q *Queries) GetEventByMessageID(ctx context.Context, messageID string) (Event, error) {
@@ -198,26 +394,38 @@ func (q *Queries) GetEventByMessageID(ctx context.Context, messageID string) (Ev
 	var i Event
 	err := row.Scan(
 		&i.ID,
+		&i.GuildID,
 		&i.MessageID,
 		&i.ChannelID,
 		&i.CreatorID,
 		&i.Title,
 		&i.Description,
+		&i.Location,
 		&i.MaxSlots,
+		&i.MaxGuests,
+		&i.AllowedRoleIds,
+		&i.PriorityRoleIds,
 		&i.ScheduledAt,
+		&i.EndsAt,
+		&i.Timezone,
 		&i.PrivateChannelID,
 		&i.QuestionsThreadID,
+		&i.FaqMessageID,
+		&i.ScheduledEventID,
 		&i.WaitlistAuto,
 		&i.OrganizerValidationDmSentAt,
 		&i.OrganizerStep1FinalizedAt,
+		&i.CancelledAt,
+		&i.CancelReason,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SeriesID,
 	)
 	return i, err
 }
 
 const getEventByPrivateChannelID = `-- name: GetEventByPrivateChannelID :one
-SELECT id, message_id, channel_id, creator_id, title, description, max_slots, scheduled_at, private_channel_id, questions_thread_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, created_at, updated_at FROM events WHERE private_channel_id = $1
+SELECT id, guild_id, message_id, channel_id, creator_id, title, description, location, max_slots, max_guests, allowed_role_ids, priority_role_ids, scheduled_at, ends_at, timezone, private_channel_id, questions_thread_id, faq_message_id, scheduled_event_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, cancelled_at, cancel_reason, created_at, updated_at, series_id FROM events WHERE private_channel_id = $1
 `
 
 func (q *Queries) GetEventByPrivateChannelID(ctx context.Context, privateChannelID string) (Event, error) {
@@ -225,30 +433,86 @@ func (q *Queries) GetEventByPrivateChannelID(ctx context.Context, privateChannel
 	var i Event
 	err := row.Scan(
 		&i.ID,
+		&i.GuildID,
+		&i.MessageID,
+		&i.ChannelID,
+		&i.CreatorID,
+		&i.Title,
+		&i.Description,
+		&i.Location,
+		&i.MaxSlots,
+		&i.MaxGuests,
+		&i.AllowedRoleIds,
+		&i.PriorityRoleIds,
+		&i.ScheduledAt,
+		&i.EndsAt,
+		&i.Timezone,
+		&i.PrivateChannelID,
+		&i.QuestionsThreadID,
+		&i.FaqMessageID,
+		&i.ScheduledEventID,
+		&i.WaitlistAuto,
+		&i.OrganizerValidationDmSentAt,
+		&i.OrganizerStep1FinalizedAt,
+		&i.CancelledAt,
+		&i.CancelReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SeriesID,
+	)
+	return i, err
+}
+
+const getEventByScheduledEventID = `-- name: GetEventByScheduledEventID :one
+SELECT id, guild_id, message_id, channel_id, creator_id, title, description, location, max_slots, max_guests, allowed_role_ids, priority_role_ids, scheduled_at, ends_at, timezone, private_channel_id, questions_thread_id, faq_message_id, scheduled_event_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, cancelled_at, cancel_reason, created_at, updated_at, series_id FROM events WHERE scheduled_event_id = $1
+`
+
+func (q *Queries) GetEventByScheduledEventID(ctx context.Context, scheduledEventID string) (Event, error) {
+	row := q.db.QueryRow(ctx, getEventByScheduledEventID, scheduledEventID)
+	var i Event
+	err := row.Scan(
+		&i.ID,
+		&i.GuildID,
 		&i.MessageID,
 		&i.ChannelID,
 		&i.CreatorID,
 		&i.Title,
 		&i.Description,
+		&i.Location,
 		&i.MaxSlots,
+		&i.MaxGuests,
+		&i.AllowedRoleIds,
+		&i.PriorityRoleIds,
 		&i.ScheduledAt,
+		&i.EndsAt,
+		&i.Timezone,
 		&i.PrivateChannelID,
 		&i.QuestionsThreadID,
+		&i.FaqMessageID,
+		&i.ScheduledEventID,
 		&i.WaitlistAuto,
 		&i.OrganizerValidationDmSentAt,
 		&i.OrganizerStep1FinalizedAt,
+		&i.CancelledAt,
+		&i.CancelReason,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SeriesID,
 	)
 	return i, err
 }
 
 const getEventsByCreatorID = `-- name: GetEventsByCreatorID :many
-SELECT id, message_id, channel_id, creator_id, title, description, max_slots, scheduled_at, private_channel_id, questions_thread_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, created_at, updated_at FROM events WHERE creator_id = $1 ORDER BY created_at DESC
+SELECT id, guild_id, message_id, channel_id, creator_id, title, description, location, max_slots, max_guests, allowed_role_ids, priority_role_ids, scheduled_at, ends_at, timezone, private_channel_id, questions_thread_id, faq_message_id, scheduled_event_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, cancelled_at, cancel_reason, created_at, updated_at, series_id FROM events WHERE guild_id = $1 AND creator_id = $2 ORDER BY created_at DESC
 `
 
-func (q *Queries) GetEventsByCreatorID(ctx context.Context, creatorID string) ([]Event, error) {
-	rows, err := q.db.Query(ctx, getEventsByCreatorID, creatorID)
+type GetEventsByCreatorIDParams struct {
+	GuildID   string
+	CreatorID string
+}
+
+func (q *Queries) GetEventsByCreatorID(ctx context.Context, arg GetEventsByCreatorIDParams) ([]Event, error) {
+	rows, err := q.db.Query(ctx, getEventsByCreatorID, arg.GuildID, arg.CreatorID)
 	if err != nil {
 		return nil, err
 	}
@@ -258,20 +522,148 @@ func (q *Queries) GetEventsByCreatorID(ctx context.Context, creatorID string) ([
 		var i Event
 		if err := rows.Scan(
 			&i.ID,
+			&i.GuildID,
 			&i.MessageID,
 			&i.ChannelID,
 			&i.CreatorID,
 			&i.Title,
 			&i.Description,
+			&i.Location,
 			&i.MaxSlots,
+			&i.MaxGuests,
+			&i.AllowedRoleIds,
+			&i.PriorityRoleIds,
 			&i.ScheduledAt,
+			&i.EndsAt,
+			&i.Timezone,
 			&i.PrivateChannelID,
 			&i.QuestionsThreadID,
+			&i.FaqMessageID,
+			&i.ScheduledEventID,
 			&i.WaitlistAuto,
 			&i.OrganizerValidationDmSentAt,
 			&i.OrganizerStep1FinalizedAt,
+			&i.CancelledAt,
+			&i.CancelReason,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SeriesID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEventsByGuildID = `-- name: GetEventsByGuildID :many
+SELECT id, guild_id, message_id, channel_id, creator_id, title, description, location, max_slots, max_guests, allowed_role_ids, priority_role_ids, scheduled_at, ends_at, timezone, private_channel_id, questions_thread_id, faq_message_id, scheduled_event_id, waitlist_auto, organizer_validation_dm_sent_at, organizer_step1_finalized_at, cancelled_at, cancel_reason, created_at, updated_at, series_id FROM events WHERE guild_id = $1 ORDER BY scheduled_at DESC NULLS LAST, id DESC
+`
+
+func (q *Queries) GetEventsByGuildID(ctx context.Context, guildID string) ([]Event, error) {
+	rows, err := q.db.Query(ctx, getEventsByGuildID, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.GuildID,
+			&i.MessageID,
+			&i.ChannelID,
+			&i.CreatorID,
+			&i.Title,
+			&i.Description,
+			&i.Location,
+			&i.MaxSlots,
+			&i.MaxGuests,
+			&i.AllowedRoleIds,
+			&i.PriorityRoleIds,
+			&i.ScheduledAt,
+			&i.EndsAt,
+			&i.Timezone,
+			&i.PrivateChannelID,
+			&i.QuestionsThreadID,
+			&i.FaqMessageID,
+			&i.ScheduledEventID,
+			&i.WaitlistAuto,
+			&i.OrganizerValidationDmSentAt,
+			&i.OrganizerStep1FinalizedAt,
+			&i.CancelledAt,
+			&i.CancelReason,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SeriesID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUpcomingEventsByParticipant = `-- name: GetUpcomingEventsByParticipant :many
+SELECT events.id, events.guild_id, events.message_id, events.channel_id, events.creator_id, events.title, events.description, events.location, events.max_slots, events.max_guests, events.allowed_role_ids, events.priority_role_ids, events.scheduled_at, events.ends_at, events.timezone, events.private_channel_id, events.questions_thread_id, events.faq_message_id, events.scheduled_event_id, events.waitlist_auto, events.organizer_validation_dm_sent_at, events.organizer_step1_finalized_at, events.cancelled_at, events.cancel_reason, events.created_at, events.updated_at, events.series_id FROM events
+JOIN participants ON participants.event_id = events.id
+WHERE participants.user_id = $1::text
+  AND participants.status = 'CONFIRMED'
+  AND events.scheduled_at IS NOT NULL
+  AND COALESCE(events.ends_at, events.scheduled_at + interval '2 hours') > $2::timestamptz
+  AND events.cancelled_at IS NULL
+ORDER BY events.scheduled_at
+`
+
+type GetUpcomingEventsByParticipantParams struct {
+	UserID string
+	Now    pgtype.Timestamptz
+}
+
+func (q *Queries) GetUpcomingEventsByParticipant(ctx context.Context, arg GetUpcomingEventsByParticipantParams) ([]Event, error) {
+	rows, err := q.db.Query(ctx, getUpcomingEventsByParticipant, arg.UserID, arg.Now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.GuildID,
+			&i.MessageID,
+			&i.ChannelID,
+			&i.CreatorID,
+			&i.Title,
+			&i.Description,
+			&i.Location,
+			&i.MaxSlots,
+			&i.MaxGuests,
+			&i.AllowedRoleIds,
+			&i.PriorityRoleIds,
+			&i.ScheduledAt,
+			&i.EndsAt,
+			&i.Timezone,
+			&i.PrivateChannelID,
+			&i.QuestionsThreadID,
+			&i.FaqMessageID,
+			&i.ScheduledEventID,
+			&i.WaitlistAuto,
+			&i.OrganizerValidationDmSentAt,
+			&i.OrganizerStep1FinalizedAt,
+			&i.CancelledAt,
+			&i.CancelReason,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SeriesID,
 		); err != nil {
 			return nil, err
 		}
@@ -301,13 +693,44 @@ func (q *Queries) MarkOrganizerValidationDMSent(ctx context.Context, id int64) e
 	return err
 }
 
+const setEventFAQMessageID = `-- name: SetEventFAQMessageID :exec
+UPDATE events SET faq_message_id = $2, updated_at = NOW() WHERE id = $1
+`
+
+type SetEventFAQMessageIDParams struct {
+	ID           int64
+	FaqMessageID string
+}
+
+func (q *Queries) SetEventFAQMessageID(ctx context.Context, arg SetEventFAQMessageIDParams) error {
+	_, err := q.db.Exec(ctx, setEventFAQMessageID, arg.ID, arg.FaqMessageID)
+	return err
+}
+
+const setEventScheduledEventID = `-- name: SetEventScheduledEventID :exec
+UPDATE events SET scheduled_event_id = $2, updated_at = NOW() WHERE id = $1
+`
+
+type SetEventScheduledEventIDParams struct {
+	ID               int64
+	ScheduledEventID string
+}
+
+func (q *Queries) SetEventScheduledEventID(ctx context.Context, arg SetEventScheduledEventIDParams) error {
+	_, err := q.db.Exec(ctx, setEventScheduledEventID, arg.ID, arg.ScheduledEventID)
+	return err
+}
+
 const updateEvent = `-- name: UpdateEvent :exec
 UPDATE events SET
     title = $2,
     description = $3,
-    max_slots = $4,
-    scheduled_at = $5,
-    waitlist_auto = $6,
+    location = $4,
+    max_slots = $5,
+    scheduled_at = $6,
+    ends_at = $7,
+    waitlist_auto = $8,
+    max_guests = $9,
     updated_at = NOW()
 WHERE id = $1
 `
@@ -316,9 +739,12 @@ type UpdateEventParams struct {
 	ID           int64
 	Title        string
 	Description  string
+	Location     string
 	MaxSlots     int32
 	ScheduledAt  pgtype.Timestamptz
+	EndsAt       pgtype.Timestamptz
 	WaitlistAuto bool
+	MaxGuests    int32
 }
 
 func (q *Queries) UpdateEvent(ctx context.Context, arg UpdateEventParams) error {
@@ -326,9 +752,42 @@ func (q *Queries) UpdateEvent(ctx context.Context, arg UpdateEventParams) error
 		arg.ID,
 		arg.Title,
 		arg.Description,
+		arg.Location,
 		arg.MaxSlots,
 		arg.ScheduledAt,
+		arg.EndsAt,
 		arg.WaitlistAuto,
+		arg.MaxGuests,
 	)
 	return err
 }
+
+const updateEventRoles = `-- name: UpdateEventRoles :exec
+UPDATE events SET allowed_role_ids = $2, priority_role_ids = $3, updated_at = NOW() WHERE id = $1
+`
+
+type UpdateEventRolesParams struct {
+	ID              int64
+	AllowedRoleIds  []string
+	PriorityRoleIds []string
+}
+
+func (q *Queries) UpdateEventRoles(ctx context.Context, arg UpdateEventRolesParams) error {
+	_, err := q.db.Exec(ctx, updateEventRoles, arg.ID, arg.AllowedRoleIds, arg.PriorityRoleIds)
+	return err
+}
+
+const updateEventSchedule = `-- name: UpdateEventSchedule :exec
+UPDATE events SET scheduled_at = $2, ends_at = $3, updated_at = NOW() WHERE id = $1
+`
+
+type UpdateEventScheduleParams struct {
+	ID          int64
+	ScheduledAt pgtype.Timestamptz
+	EndsAt      pgtype.Timestamptz
+}
+
+func (q *Queries) UpdateEventSchedule(ctx context.Context, arg UpdateEventScheduleParams) error {
+	_, err := q.db.Exec(ctx, updateEventSchedule, arg.ID, arg.ScheduledAt, arg.EndsAt)
+	return err
+}
@@ -10,29 +10,116 @@ import (
 
 type Event struct {
 	ID                          int64
+	GuildID                     string
 	MessageID                   string
 	ChannelID                   string
 	CreatorID                   string
 	Title                       string
 	Description                 string
+	Location                    string
 	MaxSlots                    int32
+	MaxGuests                   int32
+	AllowedRoleIds              []string
+	PriorityRoleIds             []string
 	ScheduledAt                 pgtype.Timestamptz
+	EndsAt                      pgtype.Timestamptz
+	Timezone                    string
 	PrivateChannelID            string
 	QuestionsThreadID           string
+	FaqMessageID                string
+	ScheduledEventID            string
 	WaitlistAuto                bool
 	OrganizerValidationDmSentAt pgtype.Timestamptz
 	OrganizerStep1FinalizedAt   pgtype.Timestamptz
+	CancelledAt                 pgtype.Timestamptz
+	CancelReason                string
 	CreatedAt                   pgtype.Timestamptz
 	UpdatedAt                   pgtype.Timestamptz
+	SeriesID                    pgtype.Int8
 }
 
-type Participant struct {
-	ID        int64
+type EventHost struct {
 	EventID   int64
 	UserID    string
-	Username  string
-	Status    string
-	JoinedAt  pgtype.Timestamptz
+	AddedBy   string
+	CreatedAt pgtype.Timestamptz
+}
+
+type EventSeries struct {
+	ID              int64
+	GuildID         string
+	CreatorID       string
+	Recurrence      string
+	Title           string
+	Description     string
+	Location        string
+	MaxSlots        int32
+	MaxGuests       int32
+	AllowedRoleIds  []string
+	PriorityRoleIds []string
+	WaitlistAuto    bool
+	NextAt          pgtype.Timestamptz
+	DurationMinutes int32
+	Timezone        string
+	AnchorDay       int32
+	CancelledAt     pgtype.Timestamptz
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+}
+
+type GuildSetting struct {
+	GuildID           string
+	ForumChannelID    string
+	PrivateCategoryID string
+	Locale            string
+	Timezone          string
+	WaitlistAuto      bool
+	OfferDelayHours   int32
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
+}
+
+type Participant struct {
+	ID             int64
+	EventID        int64
+	UserID         string
+	Username       string
+	Status         string
+	Guests         int32
+	Priority       bool
+	JoinedAt       pgtype.Timestamptz
+	OfferExpiresAt pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
+
+type Question struct {
+	ID              int64
+	EventID         int64
+	AskerID         string
+	AskerName       string
+	Question        string
+	ThreadMessageID string
+	Answer          string
+	AnswererID      string
+	AnsweredAt      pgtype.Timestamptz
+	PublishedAt     pgtype.Timestamptz
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+}
+
+type UserPreference struct {
+	UserID        string
+	Locale        string
+	CalendarToken string
+	CreatedAt     pgtype.Timestamptz
+	UpdatedAt     pgtype.Timestamptz
+}
+
+type WebhookSubscription struct {
+	ID        int64
+	GuildID   string
+	Url       string
+	Secret    string
 	CreatedAt pgtype.Timestamptz
-	UpdatedAt pgtype.Timestamptz
 }
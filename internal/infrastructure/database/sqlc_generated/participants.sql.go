@@ -27,10 +27,27 @@ func (q *Queries) CountParticipantsByEventIDAndStatus(ctx context.Context, arg C
 	return count, err
 }
 
+const countSeatsByEventIDAndStatus = `-- name: CountSeatsByEventIDAndStatus :one
+SELECT COALESCE(SUM(1 + guests), 0)::bigint FROM participants WHERE event_id = $1 AND status = $2
+`
+
+type CountSeatsByEventIDAndStatusParams struct {
+	EventID int64
+	Status  string
+}
+
+func (q *Queries) CountSeatsByEventIDAndStatus(ctx context.Context, arg CountSeatsByEventIDAndStatusParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSeatsByEventIDAndStatus, arg.EventID, arg.Status)
+	var column int64
+	err := row.Scan(&column)
+	return column, err
+}
+
 const createParticipant = `-- name: CreateParticipant :one
-INSERT INTO participants (event_id, user_id, username, status, joined_at)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id, event_id, user_id, username, status, joined_at, created_at, updated_at
+INSERT INTO participants (event_id, user_id, username, status, guests, priority, joined_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (event_id, user_id) DO NOTHING
+RETURNING id, event_id, user_id, username, status, guests, priority, joined_at, offer_expires_at, created_at, updated_at
 `
 
 type CreateParticipantParams struct {
@@ -38,6 +55,8 @@ type CreateParticipantParams struct {
 	UserID   string
 	Username string
 	Status   string
+	Guests   int32
+	Priority bool
 	JoinedAt pgtype.Timestamptz
 }
 
@@ -47,6 +66,8 @@ func (q *Queries) CreateParticipant(ctx context.Context, arg CreateParticipantPa
 		arg.UserID,
 		arg.Username,
 		arg.Status,
+		arg.Guests,
+		arg.Priority,
 		arg.JoinedAt,
 	)
 	var i Participant
@@ -56,7 +77,10 @@ func (q *Queries) CreateParticipant(ctx context.Context, arg CreateParticipantPa
 		&i.UserID,
 		&i.Username,
 		&i.Status,
+		&i.Guests,
+		&i.Priority,
 		&i.JoinedAt,
+		&i.OfferExpiresAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -72,8 +96,46 @@ func (q *Queries) DeleteParticipant(ctx context.Context, id int64) error {
 	return err
 }
 
+const getExpiredSlotOffers = `-- name: GetExpiredSlotOffers :many
+SELECT id, event_id, user_id, username, status, guests, priority, joined_at, offer_expires_at, created_at, updated_at FROM participants
+WHERE status = 'OFFERED' AND offer_expires_at <= $1::timestamptz
+ORDER BY offer_expires_at ASC
+`
+
+func (q *Queries) GetExpiredSlotOffers(ctx context.Context, now pgtype.Timestamptz) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, getExpiredSlotOffers, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.UserID,
+			&i.Username,
+			&i.Status,
+			&i.Guests,
+			&i.Priority,
+			&i.JoinedAt,
+			&i.OfferExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getParticipantByEventIDAndUserID = `-- name: GetParticipantByEventIDAndUserID :one
-SELECT id, event_id, user_id, username, status, joined_at, created_at, updated_at FROM participants WHERE event_id = $1 AND user_id = $2
+SELECT id, event_id, user_id, username, status, guests, priority, joined_at, offer_expires_at, created_at, updated_at FROM participants WHERE event_id = $1 AND user_id = $2
 `
 
 type GetParticipantByEventIDAndUserIDParams struct {
@@ -90,7 +152,10 @@ func (q *Queries) GetParticipantByEventIDAndUserID(ctx context.Context, arg GetP
 		&i.UserID,
 		&i.Username,
 		&i.Status,
+		&i.Guests,
+		&i.Priority,
 		&i.JoinedAt,
+		&i.OfferExpiresAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -98,7 +163,7 @@ func (q *Queries) GetParticipantByEventIDAndUserID(ctx context.Context, arg GetP
 }
 
 const getParticipantByID = `-- name: GetParticipantByID :one
-SELECT id, event_id, user_id, username, status, joined_at, created_at, updated_at FROM participants WHERE id = $1
+SELECT id, event_id, user_id, username, status, guests, priority, joined_at, offer_expires_at, created_at, updated_at FROM participants WHERE id = $1
 `
 
 func (q *Queries) GetParticipantByID(ctx context.Context, id int64) (Participant, error) {
@@ -110,7 +175,10 @@ func (q *Queries) GetParticipantByID(ctx context.Context, id int64) (Participant
 		&i.UserID,
 		&i.Username,
 		&i.Status,
+		&i.Guests,
+		&i.Priority,
 		&i.JoinedAt,
+		&i.OfferExpiresAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -118,7 +186,7 @@ func (q *Queries) GetParticipantByID(ctx context.Context, id int64) (Participant
 }
 
 const getParticipantsByEventID = `-- name: GetParticipantsByEventID :many
-SELECT id, event_id, user_id, username, status, joined_at, created_at, updated_at FROM participants WHERE event_id = $1 ORDER BY created_at ASC
+SELECT id, event_id, user_id, username, status, guests, priority, joined_at, offer_expires_at, created_at, updated_at FROM participants WHERE event_id = $1 ORDER BY created_at ASC
 `
 
 func (q *Queries) GetParticipantsByEventID(ctx context.Context, eventID int64) ([]Participant, error) {
@@ -136,7 +204,10 @@ func (q *Queries) GetParticipantsByEventID(ctx context.Context, eventID int64) (
 			&i.UserID,
 			&i.Username,
 			&i.Status,
+			&i.Guests,
+			&i.Priority,
 			&i.JoinedAt,
+			&i.OfferExpiresAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -151,7 +222,7 @@ func (q *Queries) GetParticipantsByEventID(ctx context.Context, eventID int64) (
 }
 
 const getParticipantsByEventIDAndStatus = `-- name: GetParticipantsByEventIDAndStatus :many
-SELECT id, event_id, user_id, username, status, joined_at, created_at, updated_at FROM participants WHERE event_id = $1 AND status = $2 ORDER BY created_at ASC
+SELECT id, event_id, user_id, username, status, guests, priority, joined_at, offer_expires_at, created_at, updated_at FROM participants WHERE event_id = $1 AND status = $2 ORDER BY created_at ASC
 `
 
 type GetParticipantsByEventIDAndStatusParams struct {
@@ -174,7 +245,10 @@ func (q *Queries) GetParticipantsByEventIDAndStatus(ctx context.Context, arg Get
 			&i.UserID,
 			&i.Username,
 			&i.Status,
+			&i.Guests,
+			&i.Priority,
 			&i.JoinedAt,
+			&i.OfferExpiresAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -188,21 +262,144 @@ func (q *Queries) GetParticipantsByEventIDAndStatus(ctx context.Context, arg Get
 	return items, nil
 }
 
+const getUpcomingParticipationsByUserID = `-- name: GetUpcomingParticipationsByUserID :many
+SELECT participants.id, participants.event_id, participants.user_id, participants.username, participants.status, participants.guests, participants.priority, participants.joined_at, participants.offer_expires_at, participants.created_at, participants.updated_at FROM participants
+JOIN events ON events.id = participants.event_id
+WHERE participants.user_id = $1::text
+  AND events.guild_id = $2::text
+  AND events.cancelled_at IS NULL
+  AND (events.scheduled_at IS NULL
+    OR COALESCE(events.ends_at, events.scheduled_at + interval '2 hours') > $3::timestamptz)
+ORDER BY events.scheduled_at NULLS LAST, events.id
+`
+
+type GetUpcomingParticipationsByUserIDParams struct {
+	UserID  string
+	GuildID string
+	Now     pgtype.Timestamptz
+}
+
+func (q *Queries) GetUpcomingParticipationsByUserID(ctx context.Context, arg GetUpcomingParticipationsByUserIDParams) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, getUpcomingParticipationsByUserID, arg.UserID, arg.GuildID, arg.Now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.UserID,
+			&i.Username,
+			&i.Status,
+			&i.Guests,
+			&i.Priority,
+			&i.JoinedAt,
+			&i.OfferExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWaitlistByEventID = `-- name: GetWaitlistByEventID :many
+SELECT id, event_id, user_id, username, status, guests, priority, joined_at, offer_expires_at, created_at, updated_at FROM participants
+WHERE event_id = $1 AND status = 'WAITLIST'
+ORDER BY priority DESC, joined_at ASC, id ASC
+`
+
+func (q *Queries) GetWaitlistByEventID(ctx context.Context, eventID int64) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, getWaitlistByEventID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.UserID,
+			&i.Username,
+			&i.Status,
+			&i.Guests,
+			&i.Priority,
+			&i.JoinedAt,
+			&i.OfferExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWaitlistRank = `-- name: GetWaitlistRank :one
+SELECT COUNT(*) FROM participants
+WHERE event_id = $1::bigint
+  AND status = 'WAITLIST'
+  AND (NOT priority, joined_at, id) <= (NOT $2::boolean, $3::timestamptz, $4::bigint)
+`
+
+type GetWaitlistRankParams struct {
+	EventID  int64
+	Priority bool
+	JoinedAt pgtype.Timestamptz
+	ID       int64
+}
+
+func (q *Queries) GetWaitlistRank(ctx context.Context, arg GetWaitlistRankParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getWaitlistRank,
+		arg.EventID,
+		arg.Priority,
+		arg.JoinedAt,
+		arg.ID,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const updateParticipant = `-- name: UpdateParticipant :exec
 UPDATE participants SET
     username = $2,
     status = $3,
+    offer_expires_at = $4,
+    guests = $5,
     updated_at = NOW()
 WHERE id = $1
 `
 
 type UpdateParticipantParams struct {
-	ID       int64
-	Username string
-	Status   string
+	ID             int64
+	Username       string
+	Status         string
+	OfferExpiresAt pgtype.Timestamptz
+	Guests         int32
 }
 
 func (q *Queries) UpdateParticipant(ctx context.Context, arg UpdateParticipantParams) error {
-	_, err := q.db.Exec(ctx, updateParticipant, arg.ID, arg.Username, arg.Status)
+	_, err := q.db.Exec(ctx, updateParticipant,
+		arg.ID,
+		arg.Username,
+		arg.Status,
+		arg.OfferExpiresAt,
+		arg.Guests,
+	)
 	return err
 }
@@ -0,0 +1,43 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"servbot/internal/infrastructure/database/sqlc_generated"
+	"servbot/internal/ports/output"
+)
+
+var _ output.UnitOfWork = (*UnitOfWork)(nil)
+
+type UnitOfWork struct {
+	pool *pgxpool.Pool
+}
+
+func NewUnitOfWork(pool *pgxpool.Pool) *UnitOfWork {
+	return &UnitOfWork{pool: pool}
+}
+
+func (u *UnitOfWork) Do(ctx context.Context, fn func(repos output.TxRepositories) error) error {
+	tx, err := u.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	// No-op once committed.
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := sqlc_generated.New(tx)
+	if err := fn(output.TxRepositories{
+		Events:       NewEventRepository(q),
+		Participants: NewParticipantRepository(q),
+		Series:       NewSeriesRepository(q),
+	}); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
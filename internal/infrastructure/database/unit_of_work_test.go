@@ -0,0 +1,133 @@
+package database_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"servbot/internal/application"
+	"servbot/internal/domain"
+	"servbot/internal/domain/domainevent"
+	"servbot/internal/domain/entities"
+	"servbot/internal/infrastructure/database"
+	"servbot/internal/infrastructure/database/sqlc_generated"
+	appi18n "servbot/internal/infrastructure/i18n"
+)
+
+// nopPublisher drops the domain events: the test only looks at the rows.
+type nopPublisher struct{}
+
+func (nopPublisher) Publish(context.Context, domainevent.Event) {}
+
+// TestJoinEventConcurrent fires concurrent joins at an event with a few slots against a real
+// Postgres, given by TEST_DATABASE_URL. Each member joins twice at the same time.
+func TestJoinEventConcurrent(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	const members, slots = 40, 7
+
+	migrations, err := filepath.Abs("../../../migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := database.RunMigrations(dsn, migrations); err != nil {
+		t.Fatalf("migrations: %v", err)
+	}
+	ctx := context.Background()
+	pool, err := database.NewPool(ctx, dsn)
+	if err != nil {
+		t.Fatalf("pool: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	q := sqlc_generated.New(pool)
+	eventRepo := database.NewEventRepository(q)
+	participantRepo := database.NewParticipantRepository(q)
+	service := application.NewParticipantService(
+		participantRepo,
+		eventRepo,
+		database.NewUnitOfWork(pool),
+		database.NewGuildSettingsRepository(q),
+		appi18n.NewTranslator("fr"),
+		nopPublisher{},
+	)
+
+	suffix := fmt.Sprint(time.Now().UnixNano())
+	event := &entities.Event{
+		GuildID:     "test-guild-" + suffix,
+		MessageID:   "test-message-" + suffix,
+		ChannelID:   "test-channel-" + suffix,
+		CreatorID:   "test-creator",
+		Title:       "Concurrent joins",
+		MaxSlots:    slots,
+		ScheduledAt: time.Now().Add(7 * 24 * time.Hour),
+		Timezone:    "Europe/Paris",
+	}
+	if err := eventRepo.Create(ctx, event); err != nil {
+		t.Fatalf("create event: %v", err)
+	}
+	t.Cleanup(func() { _ = eventRepo.Delete(context.Background(), event.ID) })
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*members)
+	for n := 0; n < 2*members; n++ {
+		userID := fmt.Sprintf("user-%d", n%members)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := service.JoinEvent(ctx, "fr", event.ID, userID, userID, nil, false)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	joined, duplicates := 0, 0
+	for err := range errs {
+		switch {
+		case err == nil:
+			joined++
+		case errors.Is(err, domain.ErrParticipantExists):
+			duplicates++
+		default:
+			t.Errorf("JoinEvent: %v", err)
+		}
+	}
+	if joined != members || duplicates != members {
+		t.Errorf("got %d joins and %d duplicates, want %d of each", joined, duplicates, members)
+	}
+
+	confirmed, err := participantRepo.FindByEventIDAndStatus(ctx, event.ID, domain.StatusConfirmed)
+	if err != nil {
+		t.Fatalf("find confirmed: %v", err)
+	}
+	waitlist, err := participantRepo.FindWaitlistByEventID(ctx, event.ID)
+	if err != nil {
+		t.Fatalf("find waitlist: %v", err)
+	}
+	if len(confirmed) != slots || len(waitlist) != members-slots {
+		t.Errorf("got %d confirmed and %d on the waitlist, want %d and %d", len(confirmed), len(waitlist), slots, members-slots)
+	}
+
+	all, err := participantRepo.FindByEventID(ctx, event.ID)
+	if err != nil {
+		t.Fatalf("find participants: %v", err)
+	}
+	seen := make(map[string]bool, len(all))
+	for _, p := range all {
+		if seen[p.UserID] {
+			t.Errorf("duplicate participant row for %s", p.UserID)
+		}
+		seen[p.UserID] = true
+	}
+	if len(all) != members {
+		t.Errorf("got %d participant rows, want %d", len(all), members)
+	}
+}
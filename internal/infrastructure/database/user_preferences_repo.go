@@ -0,0 +1,69 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"servbot/internal/domain/entities"
+	"servbot/internal/infrastructure/database/sqlc_generated"
+	"servbot/internal/ports/output"
+)
+
+var _ output.UserPreferencesRepository = (*UserPreferencesRepository)(nil)
+
+type UserPreferencesRepository struct {
+	q *sqlc_generated.Queries
+}
+
+func NewUserPreferencesRepository(q *sqlc_generated.Queries) *UserPreferencesRepository {
+	return &UserPreferencesRepository{q: q}
+}
+
+func (r *UserPreferencesRepository) FindByUserID(ctx context.Context, userID string) (*entities.UserPreferences, error) {
+	row, err := r.q.GetUserPreferences(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get user preferences: %w", err)
+	}
+	p := userPreferencesToDomain(row)
+	return &p, nil
+}
+
+func (r *UserPreferencesRepository) FindByCalendarToken(ctx context.Context, token string) (*entities.UserPreferences, error) {
+	row, err := r.q.GetUserPreferencesByCalendarToken(ctx, token)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get user preferences by calendar token: %w", err)
+	}
+	p := userPreferencesToDomain(row)
+	return &p, nil
+}
+
+func (r *UserPreferencesRepository) Save(ctx context.Context, prefs *entities.UserPreferences) error {
+	err := r.q.UpsertUserPreferences(ctx, sqlc_generated.UpsertUserPreferencesParams{
+		UserID: prefs.UserID,
+		Locale: prefs.Locale,
+	})
+	if err != nil {
+		return fmt.Errorf("upsert user preferences: %w", err)
+	}
+	return nil
+}
+
+func (r *UserPreferencesRepository) SetCalendarToken(ctx context.Context, userID, token string) error {
+	err := r.q.UpsertUserCalendarToken(ctx, sqlc_generated.UpsertUserCalendarTokenParams{
+		UserID:        userID,
+		CalendarToken: token,
+	})
+	if err != nil {
+		return fmt.Errorf("upsert user calendar token: %w", err)
+	}
+	return nil
+}
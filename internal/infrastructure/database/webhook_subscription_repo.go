@@ -0,0 +1,67 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"servbot/internal/domain"
+	"servbot/internal/domain/entities"
+	"servbot/internal/infrastructure/database/sqlc_generated"
+	"servbot/internal/ports/output"
+)
+
+var _ output.WebhookSubscriptionRepository = (*WebhookSubscriptionRepository)(nil)
+
+type WebhookSubscriptionRepository struct {
+	q *sqlc_generated.Queries
+}
+
+func NewWebhookSubscriptionRepository(q *sqlc_generated.Queries) *WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{q: q}
+}
+
+func (r *WebhookSubscriptionRepository) Create(ctx context.Context, sub *entities.WebhookSubscription) error {
+	row, err := r.q.CreateWebhookSubscription(ctx, sqlc_generated.CreateWebhookSubscriptionParams{
+		GuildID: sub.GuildID,
+		Url:     sub.URL,
+		Secret:  sub.Secret,
+	})
+	if err != nil {
+		return fmt.Errorf("create webhook subscription: %w", err)
+	}
+	*sub = webhookSubscriptionToDomain(row)
+	return nil
+}
+
+func (r *WebhookSubscriptionRepository) FindByGuildID(ctx context.Context, guildID string) ([]entities.WebhookSubscription, error) {
+	rows, err := r.q.GetWebhookSubscriptionsByGuildID(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("get webhook subscriptions by guild id: %w", err)
+	}
+	out := make([]entities.WebhookSubscription, len(rows))
+	for i := range rows {
+		out[i] = webhookSubscriptionToDomain(rows[i])
+	}
+	return out, nil
+}
+
+func (r *WebhookSubscriptionRepository) Delete(ctx context.Context, guildID string, id uint) error {
+	n, err := r.q.DeleteWebhookSubscription(ctx, sqlc_generated.DeleteWebhookSubscriptionParams{
+		ID:      int64(id),
+		GuildID: guildID,
+	})
+	if err != nil {
+		return fmt.Errorf("delete webhook subscription: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *WebhookSubscriptionRepository) DeleteByGuildID(ctx context.Context, guildID string) error {
+	if err := r.q.DeleteWebhookSubscriptionsByGuildID(ctx, guildID); err != nil {
+		return fmt.Errorf("delete webhook subscriptions by guild id: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Package eventbus is the in-process implementation of output.DomainEventPublisher.
+package eventbus
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"servbot/internal/domain/domainevent"
+	"servbot/internal/ports/output"
+)
+
+var _ output.DomainEventPublisher = (*Bus)(nil)
+
+// Subscriber applies the side effects of a domain event; it ignores the types it does not handle.
+type Subscriber func(ctx context.Context, e domainevent.Event)
+
+// Bus calls its subscribers synchronously, in subscription order. A subscriber may publish
+// further events (e.g. a promotion following SlotFreed).
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers []Subscriber
+}
+
+func New() *Bus {
+	return &Bus{}
+}
+
+func (b *Bus) Subscribe(sub Subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers = append(b.subscribers, sub)
+}
+
+func (b *Bus) Publish(ctx context.Context, e domainevent.Event) {
+	b.mu.RLock()
+	subscribers := b.subscribers
+	b.mu.RUnlock()
+	for _, sub := range subscribers {
+		dispatch(ctx, sub, e)
+	}
+}
+
+// dispatch keeps a failing subscriber from breaking the use case that published the event.
+func dispatch(ctx context.Context, sub Subscriber, e domainevent.Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("❌ Abonné de %s: panic: %v", e.Name(), r)
+		}
+	}()
+	sub(ctx, e)
+}
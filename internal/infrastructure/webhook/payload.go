@@ -0,0 +1,88 @@
+package webhook
+
+import (
+	"time"
+
+	"servbot/internal/domain/entities"
+)
+
+type payload struct {
+	ID          string              `json:"id"`
+	Type        string              `json:"type"`
+	OccurredAt  time.Time           `json:"occurred_at"`
+	GuildID     string              `json:"guild_id"`
+	Event       eventPayload        `json:"event"`
+	Participant *participantPayload `json:"participant,omitempty"`
+}
+
+type eventPayload struct {
+	ID           uint       `json:"id"`
+	ChannelID    string     `json:"channel_id"`
+	MessageID    string     `json:"message_id"`
+	CreatorID    string     `json:"creator_id"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	Location     string     `json:"location,omitempty"`
+	MaxSlots     int        `json:"max_slots"` // 0 = unlimited
+	MaxGuests    int        `json:"max_guests"`
+	ScheduledAt  *time.Time `json:"scheduled_at,omitempty"`
+	EndsAt       *time.Time `json:"ends_at,omitempty"`
+	SeriesID     uint       `json:"series_id,omitempty"`
+	FinalizedAt  *time.Time `json:"finalized_at,omitempty"`
+	CancelledAt  *time.Time `json:"cancelled_at,omitempty"`
+	CancelReason string     `json:"cancel_reason,omitempty"`
+}
+
+type participantPayload struct {
+	ID       uint      `json:"id"`
+	UserID   string    `json:"user_id"`
+	Username string    `json:"username"`
+	Status   string    `json:"status"`
+	Guests   int       `json:"guests"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+func toPayload(deliveryID string, n entities.WebhookNotification) payload {
+	e := n.Event
+	out := payload{
+		ID:         deliveryID,
+		Type:       n.Type,
+		OccurredAt: n.OccurredAt,
+		GuildID:    e.GuildID,
+		Event: eventPayload{
+			ID:           e.ID,
+			ChannelID:    e.ChannelID,
+			MessageID:    e.MessageID,
+			CreatorID:    e.CreatorID,
+			Title:        e.Title,
+			Description:  e.Description,
+			Location:     e.Location,
+			MaxSlots:     e.MaxSlots,
+			MaxGuests:    e.MaxGuests,
+			ScheduledAt:  optionalTime(e.ScheduledAt),
+			EndsAt:       optionalTime(e.EndsAt),
+			SeriesID:     e.SeriesID,
+			FinalizedAt:  optionalTime(e.OrganizerStep1FinalizedAt),
+			CancelledAt:  optionalTime(e.CancelledAt),
+			CancelReason: e.CancelReason,
+		},
+	}
+	if p := n.Participant; p != nil {
+		out.Participant = &participantPayload{
+			ID:       p.ID,
+			UserID:   p.UserID,
+			Username: p.Username,
+			Status:   p.Status,
+			Guests:   p.Guests,
+			JoinedAt: p.JoinedAt,
+		}
+	}
+	return out
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,171 @@
+// Package webhook delivers lifecycle notifications to the webhook subscriptions of a guild
+// as HMAC-signed JSON POST requests.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"servbot/internal/domain/domainevent"
+	"servbot/internal/domain/entities"
+	"servbot/internal/ports/output"
+)
+
+const (
+	defaultMaxAttempts = 5
+	defaultBaseDelay   = 2 * time.Second
+	requestTimeout     = 10 * time.Second
+
+	HeaderEvent     = "X-Servbot-Event"
+	HeaderDelivery  = "X-Servbot-Delivery"
+	HeaderTimestamp = "X-Servbot-Timestamp"
+	// HeaderSignature is "sha256=" + hex(HMAC-SHA256(secret, timestamp + "." + body)).
+	HeaderSignature = "X-Servbot-Signature"
+)
+
+// Publisher subscribes to the domain events and forwards the lifecycle ones to the webhooks.
+type Publisher struct {
+	subsRepo    output.WebhookSubscriptionRepository
+	client      *http.Client
+	maxAttempts int
+	baseDelay   time.Duration // delay before the first retry, doubled for each next one
+}
+
+func NewPublisher(subsRepo output.WebhookSubscriptionRepository) *Publisher {
+	return &Publisher{
+		subsRepo:    subsRepo,
+		client:      &http.Client{Timeout: requestTimeout},
+		maxAttempts: defaultMaxAttempts,
+		baseDelay:   defaultBaseDelay,
+	}
+}
+
+// HandleDomainEvent sends the notification of e to the subscriptions of the event's guild
+// in the background. Events without a webhook counterpart (SlotFreed) are ignored.
+func (p *Publisher) HandleDomainEvent(ctx context.Context, e domainevent.Event) {
+	n, ok := notificationFor(e)
+	if !ok || n.Event.GuildID == "" {
+		return
+	}
+	go p.publish(n)
+}
+
+func notificationFor(e domainevent.Event) (entities.WebhookNotification, bool) {
+	n := entities.WebhookNotification{Type: e.Name(), OccurredAt: time.Now()}
+	switch e := e.(type) {
+	case domainevent.EventCreated:
+		n.Event = e.Event
+	case domainevent.EventEdited:
+		n.Event = e.Event
+	case domainevent.EventFinalized:
+		n.Event = e.Event
+	case domainevent.EventCancelled:
+		n.Event = e.Event
+	case domainevent.ParticipantJoined:
+		n.Event, n.Participant = e.Event, &e.Participant
+	case domainevent.ParticipantLeft:
+		n.Event, n.Participant = e.Event, &e.Participant
+	case domainevent.ParticipantRemoved:
+		n.Event, n.Participant = e.Event, &e.Participant
+	case domainevent.ParticipantPromoted:
+		n.Event, n.Participant = e.Event, &e.Participant
+	case domainevent.ParticipantOffered:
+		n.Event, n.Participant = e.Event, &e.Participant
+	case domainevent.ParticipantDeclinedOffer:
+		n.Event, n.Participant = e.Event, &e.Participant
+	case domainevent.ParticipantGuestsChanged:
+		n.Event, n.Participant = e.Event, &e.Participant
+	default:
+		return n, false
+	}
+	return n, true
+}
+
+func (p *Publisher) publish(n entities.WebhookNotification) {
+	ctx := context.Background()
+	subs, err := p.subsRepo.FindByGuildID(ctx, n.Event.GuildID)
+	if err != nil {
+		log.Printf("❌ Webhooks du serveur %s: %v", n.Event.GuildID, err)
+		return
+	}
+	if len(subs) == 0 {
+		return
+	}
+	deliveryID := newDeliveryID()
+	body, err := json.Marshal(toPayload(deliveryID, n))
+	if err != nil {
+		log.Printf("❌ Webhook %s: encodage JSON: %v", n.Type, err)
+		return
+	}
+	for _, sub := range subs {
+		go p.deliver(ctx, sub, n.Type, deliveryID, body)
+	}
+}
+
+// deliver POSTs body until the subscriber answers 2xx, retrying with exponential backoff
+// on network errors, 5xx, 408 and 429. Other 4xx answers are final.
+func (p *Publisher) deliver(ctx context.Context, sub entities.WebhookSubscription, eventType, deliveryID string, body []byte) {
+	delay := p.baseDelay
+	for attempt := 1; ; attempt++ {
+		retry, err := p.send(ctx, sub, eventType, deliveryID, body)
+		if err == nil {
+			return
+		}
+		if !retry || attempt >= p.maxAttempts {
+			log.Printf("❌ Webhook %s vers %s (abonnement %d) abandonné après %d tentative(s): %v", eventType, sub.URL, sub.ID, attempt, err)
+			return
+		}
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+func (p *Publisher) send(ctx context.Context, sub entities.WebhookSubscription, eventType, deliveryID string, body []byte) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "servBot-Webhook")
+	req.Header.Set(HeaderEvent, eventType)
+	req.Header.Set(HeaderDelivery, deliveryID)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, timestamp, body))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry = resp.StatusCode >= 500 || resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode == http.StatusTooManyRequests
+	return retry, fmt.Errorf("statut HTTP %d", resp.StatusCode)
+}
+
+// Sign returns the X-Servbot-Signature value of a delivery, for subscribers to compare against.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newDeliveryID() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,103 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"servbot/internal/domain/entities"
+)
+
+type request struct {
+	header http.Header
+	body   []byte
+}
+
+// recorder answers the deliveries with statuses in turn, the last one repeated, and keeps
+// the requests it got.
+type recorder struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []request
+}
+
+func (rec *recorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	status := rec.statuses[min(len(rec.requests), len(rec.statuses)-1)]
+	rec.requests = append(rec.requests, request{header: r.Header.Clone(), body: body})
+	w.WriteHeader(status)
+}
+
+func deliverTo(t *testing.T, statuses ...int) (*recorder, entities.WebhookSubscription, []byte) {
+	t.Helper()
+	rec := &recorder{statuses: statuses}
+	srv := httptest.NewServer(rec)
+	t.Cleanup(srv.Close)
+
+	p := &Publisher{client: srv.Client(), maxAttempts: 3, baseDelay: time.Millisecond}
+	sub := entities.WebhookSubscription{ID: 1, URL: srv.URL, Secret: "s3cret"}
+	body := []byte(`{"type":"participant.joined","delivery_id":"d1"}`)
+	p.deliver(context.Background(), sub, "participant.joined", "d1", body)
+	return rec, sub, body
+}
+
+func TestDeliverSignsTheBody(t *testing.T) {
+	rec, sub, body := deliverTo(t, http.StatusOK)
+	if len(rec.requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(rec.requests))
+	}
+	req := rec.requests[0]
+	if string(req.body) != string(body) {
+		t.Errorf("body = %s, want %s", req.body, body)
+	}
+	if got := req.header.Get(HeaderEvent); got != "participant.joined" {
+		t.Errorf("%s = %q, want participant.joined", HeaderEvent, got)
+	}
+	if got := req.header.Get(HeaderDelivery); got != "d1" {
+		t.Errorf("%s = %q, want d1", HeaderDelivery, got)
+	}
+
+	mac := hmac.New(sha256.New, []byte(sub.Secret))
+	mac.Write([]byte(req.header.Get(HeaderTimestamp) + "."))
+	mac.Write(req.body)
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if got := req.header.Get(HeaderSignature); got != want {
+		t.Errorf("%s = %q, want %q", HeaderSignature, got, want)
+	}
+}
+
+func TestDeliverRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []int
+		want     int
+	}{
+		{"2xx is final", []int{http.StatusNoContent}, 1},
+		{"5xx then 2xx", []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK}, 3},
+		{"429 then 2xx", []int{http.StatusTooManyRequests, http.StatusOK}, 2},
+		{"4xx is final", []int{http.StatusNotFound}, 1},
+		{"5xx until max attempts", []int{http.StatusInternalServerError}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, _, body := deliverTo(t, tt.statuses...)
+			if len(rec.requests) != tt.want {
+				t.Fatalf("got %d requests, want %d", len(rec.requests), tt.want)
+			}
+			for _, req := range rec.requests {
+				if string(req.body) != string(body) || req.header.Get(HeaderDelivery) != "d1" {
+					t.Errorf("retry sent %s (delivery %q), want the same delivery", req.body, req.header.Get(HeaderDelivery))
+				}
+			}
+		})
+	}
+}
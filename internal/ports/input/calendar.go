@@ -0,0 +1,13 @@
+package input
+
+import (
+	"context"
+
+	"servbot/internal/domain/entities"
+)
+
+type CalendarUseCase interface {
+	UpcomingEvents(ctx context.Context, userID string) ([]entities.Event, error)
+	FeedToken(ctx context.Context, userID string, renew bool) (string, error)
+	EventsForFeedToken(ctx context.Context, token string) ([]entities.Event, error)
+}
@@ -12,12 +12,30 @@ type EventUseCase interface {
 	GetEventByMessageID(ctx context.Context, messageID string) (*entities.Event, error)
 	GetEventByID(ctx context.Context, id uint) (*entities.Event, error)
 	GetEventByPrivateChannelID(ctx context.Context, privateChannelID string) (*entities.Event, error)
-	UpdateEvent(ctx context.Context, event *entities.Event) error
+	GetEventByScheduledEventID(ctx context.Context, scheduledEventID string) (*entities.Event, error)
+	SetScheduledEventID(ctx context.Context, eventID uint, scheduledEventID string) error
+	MarkScheduledEventStarted(ctx context.Context, scheduledEventID string, at time.Time) (*entities.Event, error)
+	MarkScheduledEventEnded(ctx context.Context, scheduledEventID string, at time.Time) (*entities.Event, error)
+	UpdateEvent(ctx context.Context, event *entities.Event, hostID string) error
+	SetEventRoles(ctx context.Context, eventID uint, hostID string, allowedRoleIDs, priorityRoleIDs []string) (*entities.Event, error)
+	// CanManageEvent reports whether userID organizes or co-hosts event.
+	CanManageEvent(event *entities.Event, userID string) bool
+	AddCoHost(ctx context.Context, eventID uint, requesterID, userID string) (*entities.Event, error)
+	RemoveCoHost(ctx context.Context, eventID uint, requesterID, userID string) (*entities.Event, error)
 	GetWaitlistParticipants(ctx context.Context, eventID uint) ([]entities.Participant, error)
 	GetConfirmedParticipants(ctx context.Context, eventID uint) ([]entities.Participant, error)
-	GetEventsByCreatorID(ctx context.Context, creatorID string) ([]entities.Event, error)
+	GetEventsByGuildID(ctx context.Context, guildID string) ([]entities.Event, error)
+	GetEventsByCreatorID(ctx context.Context, guildID, creatorID string) ([]entities.Event, error)
 	EventsNeedingH48OrganizerDM(ctx context.Context, now time.Time) ([]entities.Event, error)
 	FindStartedNonFinalizedEvents(ctx context.Context, now time.Time) ([]entities.Event, error)
+	FindRecentlyEndedEvents(ctx context.Context, now time.Time) ([]entities.Event, error)
 	MarkOrganizerValidationDMSent(ctx context.Context, eventID uint) error
-	FinalizeOrganizerStep1(ctx context.Context, eventID uint, creatorID string) (*entities.Event, error)
+	FinalizeOrganizerStep1(ctx context.Context, eventID uint, hostID string) (*entities.Event, error)
+	CancelEvent(ctx context.Context, eventID uint, hostID, reason string) (*entities.Event, error)
+	CreateSeries(ctx context.Context, event *entities.Event, recurrence, creatorUsername string) error
+	GetSeriesByID(ctx context.Context, id uint) (*entities.Series, error)
+	SeriesNeedingNextOccurrence(ctx context.Context, now time.Time) ([]entities.Series, error)
+	CreateNextOccurrence(ctx context.Context, series *entities.Series, event *entities.Event, creatorUsername string) error
+	UpdateSeries(ctx context.Context, event *entities.Event, hostID string) error
+	CancelSeries(ctx context.Context, eventID uint, hostID string) (*entities.Series, error)
 }
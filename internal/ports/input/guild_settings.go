@@ -0,0 +1,14 @@
+package input
+
+import (
+	"context"
+
+	"servbot/internal/domain/entities"
+)
+
+type GuildSettingsUseCase interface {
+	GetGuildSettings(ctx context.Context, guildID string) (*entities.GuildSettings, error)
+	UpdateGuildSettings(ctx context.Context, settings *entities.GuildSettings) error
+	SeedGuildSettings(ctx context.Context, guildID, forumChannelID string) error
+	RemoveGuild(ctx context.Context, guildID string) error
+}
@@ -2,16 +2,24 @@ package input
 
 import (
 	"context"
+	"time"
 
 	"servbot/internal/domain/entities"
 )
 
 type ParticipantUseCase interface {
-	JoinEvent(ctx context.Context, locale string, eventID uint, userID, username string, forceWaitlist bool) (string, error)
+	JoinEvent(ctx context.Context, locale string, eventID uint, userID, username string, roleIDs []string, forceWaitlist bool) (string, error)
 	LeaveEvent(ctx context.Context, eventID uint, userID string) (bool, error)
 	GetParticipantByEventIDAndUserID(ctx context.Context, eventID uint, userID string) (*entities.Participant, error)
 	GetParticipantByID(ctx context.Context, id uint) (*entities.Participant, error)
-	PromoteParticipant(ctx context.Context, participantID uint, creatorID string) (*entities.Participant, bool, error)
-	RemoveParticipant(ctx context.Context, participantID uint, creatorID string) (*entities.Participant, error)
-	GetNextWaitlistParticipant(ctx context.Context, eventID uint) (*entities.Participant, error)
+	GetRoster(ctx context.Context, eventID uint) ([]entities.Participant, error)
+	GetUpcomingRegistrations(ctx context.Context, guildID, userID string, now time.Time) ([]entities.Registration, error)
+	SetGuests(ctx context.Context, eventID uint, userID string, guests int) (*entities.Participant, error)
+	PromoteParticipant(ctx context.Context, participantID uint, hostID string) (*entities.Participant, bool, error)
+	RemoveParticipant(ctx context.Context, participantID uint, hostID string) (*entities.Participant, error)
+	RefuseParticipant(ctx context.Context, participantID uint, hostID string) (*entities.Participant, error)
+	OfferSlotToNextWaitlist(ctx context.Context, eventID uint, now time.Time) ([]entities.Participant, error)
+	AcceptSlotOffer(ctx context.Context, participantID uint, userID string, now time.Time) (*entities.Participant, error)
+	DeclineSlotOffer(ctx context.Context, participantID uint, userID string) (*entities.Participant, error)
+	ExpireSlotOffers(ctx context.Context, now time.Time) error
 }
@@ -0,0 +1,17 @@
+package input
+
+import (
+	"context"
+
+	"servbot/internal/domain/entities"
+)
+
+type QuestionUseCase interface {
+	AskQuestion(ctx context.Context, eventID uint, askerID, askerName, text string) (*entities.Question, error)
+	AttachThreadMessage(ctx context.Context, questionID uint, messageID string) error
+	GetQuestionByID(ctx context.Context, id uint) (*entities.Question, error)
+	AnswerQuestion(ctx context.Context, questionID uint, answererID, answer string, publish bool) (*entities.Question, error)
+	GetPublishedQuestions(ctx context.Context, eventID uint) ([]entities.Question, error)
+	AttachFAQMessage(ctx context.Context, eventID uint, messageID string) error
+	GetUnansweredQuestions(ctx context.Context, eventID uint, requesterID string) ([]entities.Question, error)
+}
@@ -0,0 +1,12 @@
+package input
+
+import (
+	"context"
+
+	"servbot/internal/domain/entities"
+)
+
+type UserPreferencesUseCase interface {
+	GetUserPreferences(ctx context.Context, userID string) (*entities.UserPreferences, error)
+	SetUserLocale(ctx context.Context, userID, locale string) error
+}
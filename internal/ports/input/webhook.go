@@ -0,0 +1,13 @@
+package input
+
+import (
+	"context"
+
+	"servbot/internal/domain/entities"
+)
+
+type WebhookUseCase interface {
+	CreateSubscription(ctx context.Context, guildID, url string) (*entities.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, guildID string) ([]entities.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, guildID string, id uint) error
+}
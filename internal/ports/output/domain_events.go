@@ -0,0 +1,13 @@
+package output
+
+import (
+	"context"
+
+	"servbot/internal/domain/domainevent"
+)
+
+// DomainEventPublisher hands the facts published by the application services to the subscribers
+// of the adapters. Publish returns once every subscriber has run.
+type DomainEventPublisher interface {
+	Publish(ctx context.Context, e domainevent.Event)
+}
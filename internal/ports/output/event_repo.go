@@ -11,12 +11,28 @@ type EventRepository interface {
 	Create(ctx context.Context, event *entities.Event) error
 	FindByMessageID(ctx context.Context, messageID string) (*entities.Event, error)
 	FindByID(ctx context.Context, id uint) (*entities.Event, error)
+	// FindByIDForUpdate is FindByID locking the event row until the end of the transaction.
+	FindByIDForUpdate(ctx context.Context, id uint) (*entities.Event, error)
 	FindByPrivateChannelID(ctx context.Context, privateChannelID string) (*entities.Event, error)
-	FindByCreatorID(ctx context.Context, creatorID string) ([]entities.Event, error)
+	FindByScheduledEventID(ctx context.Context, scheduledEventID string) (*entities.Event, error)
+	FindByGuildID(ctx context.Context, guildID string) ([]entities.Event, error)
+	FindByCreatorID(ctx context.Context, guildID, creatorID string) ([]entities.Event, error)
+	FindUpcomingByParticipant(ctx context.Context, userID string, now time.Time) ([]entities.Event, error)
 	FindEventsNeedingH48OrganizerDM(ctx context.Context, now time.Time) ([]entities.Event, error)
 	FindStartedNonFinalizedEvents(ctx context.Context, now time.Time) ([]entities.Event, error)
+	FindRecentlyEndedEvents(ctx context.Context, now time.Time) ([]entities.Event, error)
 	Update(ctx context.Context, event *entities.Event) error
+	UpdateRoles(ctx context.Context, event *entities.Event) error
+	// AddCoHost returns domain.ErrAlreadyCoHost when userID already co-hosts the event.
+	AddCoHost(ctx context.Context, eventID uint, userID, addedBy string) error
+	// RemoveCoHost returns domain.ErrCoHostNotFound when userID does not co-host the event.
+	RemoveCoHost(ctx context.Context, eventID uint, userID string) error
 	MarkOrganizerValidationDMSent(ctx context.Context, eventID uint) error
 	MarkOrganizerStep1Finalized(ctx context.Context, eventID uint) error
+	MarkCancelled(ctx context.Context, eventID uint, reason string) error
+	SetFAQMessageID(ctx context.Context, eventID uint, messageID string) error
+	SetScheduledEventID(ctx context.Context, eventID uint, scheduledEventID string) error
+	UpdateSchedule(ctx context.Context, eventID uint, scheduledAt, endsAt time.Time) error
 	Delete(ctx context.Context, id uint) error
+	DeleteByGuildID(ctx context.Context, guildID string) error
 }
@@ -0,0 +1,14 @@
+package output
+
+import (
+	"context"
+
+	"servbot/internal/domain/entities"
+)
+
+type GuildSettingsRepository interface {
+	// FindByGuildID returns nil, nil when the guild has no stored settings.
+	FindByGuildID(ctx context.Context, guildID string) (*entities.GuildSettings, error)
+	Save(ctx context.Context, settings *entities.GuildSettings) error
+	Delete(ctx context.Context, guildID string) error
+}
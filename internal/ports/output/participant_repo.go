@@ -2,6 +2,7 @@ package output
 
 import (
 	"context"
+	"time"
 
 	"servbot/internal/domain/entities"
 )
@@ -12,7 +13,17 @@ type ParticipantRepository interface {
 	FindByEventID(ctx context.Context, eventID uint) ([]entities.Participant, error)
 	FindByEventIDAndUserID(ctx context.Context, eventID uint, userID string) (*entities.Participant, error)
 	FindByEventIDAndStatus(ctx context.Context, eventID uint, status string) ([]entities.Participant, error)
+	// FindUpcomingByUserID returns the registrations of userID to the events of guildID that are
+	// neither cancelled nor over, soonest first.
+	FindUpcomingByUserID(ctx context.Context, guildID, userID string, now time.Time) ([]entities.Participant, error)
+	// WaitlistRank is the 1-based position of a waitlisted participant, by join time.
+	WaitlistRank(ctx context.Context, participant *entities.Participant) (int, error)
+	FindExpiredOffers(ctx context.Context, now time.Time) ([]entities.Participant, error)
 	Update(ctx context.Context, participant *entities.Participant) error
 	Delete(ctx context.Context, participant *entities.Participant) error
+	// FindWaitlistByEventID returns the waitlist in the order slots are offered: priority
+	// participants first, then by join time.
+	FindWaitlistByEventID(ctx context.Context, eventID uint) ([]entities.Participant, error)
 	CountByEventIDAndStatus(ctx context.Context, eventID uint, status string) (int64, error)
+	CountSeatsByEventIDAndStatus(ctx context.Context, eventID uint, status string) (int64, error)
 }
@@ -0,0 +1,19 @@
+package output
+
+import (
+	"context"
+
+	"servbot/internal/domain/entities"
+)
+
+type QuestionRepository interface {
+	Create(ctx context.Context, question *entities.Question) error
+	FindByID(ctx context.Context, id uint) (*entities.Question, error)
+	FindByEventID(ctx context.Context, eventID uint) ([]entities.Question, error)
+	FindUnansweredByEventID(ctx context.Context, eventID uint) ([]entities.Question, error)
+	FindPublishedByEventID(ctx context.Context, eventID uint) ([]entities.Question, error)
+	SetThreadMessageID(ctx context.Context, id uint, messageID string) error
+	// Answer saves the answer of a question not answered yet, and publishes it to the FAQ when
+	// publish is set, ErrQuestionAlreadyAnswered otherwise.
+	Answer(ctx context.Context, question *entities.Question, publish bool) error
+}
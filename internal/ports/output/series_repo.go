@@ -0,0 +1,21 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"servbot/internal/domain/entities"
+)
+
+type SeriesRepository interface {
+	Create(ctx context.Context, series *entities.Series) error
+	FindByID(ctx context.Context, id uint) (*entities.Series, error)
+	FindNeedingNextOccurrence(ctx context.Context, now time.Time) ([]entities.Series, error)
+	Update(ctx context.Context, series *entities.Series) error
+	// Advance moves NextAt forward from previous, ErrSeriesAlreadyAdvanced when another run
+	// already moved it.
+	Advance(ctx context.Context, series *entities.Series, previous time.Time) error
+	UpdateRoles(ctx context.Context, series *entities.Series) error
+	Cancel(ctx context.Context, id uint) error
+	DeleteByGuildID(ctx context.Context, guildID string) error
+}
@@ -0,0 +1,16 @@
+package output
+
+import "context"
+
+// TxRepositories are the repositories bound to the transaction of a UnitOfWork.
+type TxRepositories struct {
+	Events       EventRepository
+	Participants ParticipantRepository
+	Series       SeriesRepository
+}
+
+// UnitOfWork runs fn in a database transaction, committed when fn returns nil and rolled back
+// otherwise. The error of fn is returned as is.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(repos TxRepositories) error) error
+}
@@ -0,0 +1,16 @@
+package output
+
+import (
+	"context"
+
+	"servbot/internal/domain/entities"
+)
+
+type UserPreferencesRepository interface {
+	// FindByUserID returns nil, nil when the user has no stored preferences.
+	FindByUserID(ctx context.Context, userID string) (*entities.UserPreferences, error)
+	// FindByCalendarToken returns nil, nil when no user owns the token.
+	FindByCalendarToken(ctx context.Context, token string) (*entities.UserPreferences, error)
+	Save(ctx context.Context, prefs *entities.UserPreferences) error
+	SetCalendarToken(ctx context.Context, userID, token string) error
+}
@@ -0,0 +1,15 @@
+package output
+
+import (
+	"context"
+
+	"servbot/internal/domain/entities"
+)
+
+type WebhookSubscriptionRepository interface {
+	Create(ctx context.Context, sub *entities.WebhookSubscription) error
+	FindByGuildID(ctx context.Context, guildID string) ([]entities.WebhookSubscription, error)
+	// Delete returns domain.ErrWebhookNotFound when the guild has no subscription with this ID.
+	Delete(ctx context.Context, guildID string, id uint) error
+	DeleteByGuildID(ctx context.Context, guildID string) error
+}
@@ -0,0 +1,4 @@
+ALTER TABLE events
+    DROP COLUMN IF EXISTS series_id;
+
+DROP TABLE IF EXISTS event_series;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS event_series (
+    id BIGSERIAL PRIMARY KEY,
+    creator_id TEXT NOT NULL,
+    recurrence TEXT NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL,
+    max_slots INT NOT NULL DEFAULT 0,
+    waitlist_auto BOOLEAN NOT NULL DEFAULT TRUE,
+    next_at TIMESTAMPTZ NOT NULL,
+    cancelled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS series_id BIGINT REFERENCES event_series(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_events_series_id ON events(series_id);
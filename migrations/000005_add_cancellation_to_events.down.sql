@@ -0,0 +1,3 @@
+ALTER TABLE events
+    DROP COLUMN IF EXISTS cancel_reason,
+    DROP COLUMN IF EXISTS cancelled_at;
@@ -0,0 +1,3 @@
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS cancel_reason TEXT NOT NULL DEFAULT '';
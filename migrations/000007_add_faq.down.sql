@@ -0,0 +1,5 @@
+ALTER TABLE events
+    DROP COLUMN IF EXISTS faq_message_id;
+
+ALTER TABLE questions
+    DROP COLUMN IF EXISTS published_at;
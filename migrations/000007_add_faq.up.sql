@@ -0,0 +1,5 @@
+ALTER TABLE questions
+    ADD COLUMN IF NOT EXISTS published_at TIMESTAMPTZ;
+
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS faq_message_id TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,4 @@
+ALTER TABLE event_series
+    DROP COLUMN IF EXISTS guild_id;
+
+DROP TABLE IF EXISTS guild_settings;
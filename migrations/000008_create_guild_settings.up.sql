@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS guild_settings (
+    guild_id TEXT PRIMARY KEY,
+    forum_channel_id TEXT NOT NULL DEFAULT '',
+    private_category_id TEXT NOT NULL DEFAULT '',
+    locale TEXT NOT NULL DEFAULT 'fr',
+    timezone TEXT NOT NULL DEFAULT 'Europe/Paris',
+    waitlist_auto BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE event_series
+    ADD COLUMN IF NOT EXISTS guild_id TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_events_guild_id;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS guild_id;
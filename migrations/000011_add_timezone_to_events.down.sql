@@ -0,0 +1,5 @@
+ALTER TABLE event_series
+    DROP COLUMN IF EXISTS timezone;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS timezone;
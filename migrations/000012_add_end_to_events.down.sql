@@ -0,0 +1,5 @@
+ALTER TABLE event_series
+    DROP COLUMN IF EXISTS duration_minutes;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS ends_at;
@@ -0,0 +1,5 @@
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS ends_at TIMESTAMPTZ;
+
+ALTER TABLE event_series
+    ADD COLUMN IF NOT EXISTS duration_minutes INT NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+ALTER TABLE event_series
+    DROP COLUMN IF EXISTS location;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS location;
@@ -0,0 +1,5 @@
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS location TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE event_series
+    ADD COLUMN IF NOT EXISTS location TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_events_scheduled_event_id;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS scheduled_event_id;
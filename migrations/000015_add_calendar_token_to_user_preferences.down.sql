@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_user_preferences_calendar_token;
+
+ALTER TABLE user_preferences
+    DROP COLUMN IF EXISTS calendar_token;
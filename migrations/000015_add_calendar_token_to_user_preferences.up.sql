@@ -0,0 +1,5 @@
+ALTER TABLE user_preferences
+    ADD COLUMN IF NOT EXISTS calendar_token TEXT NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_preferences_calendar_token
+    ON user_preferences(calendar_token) WHERE calendar_token <> '';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_participants_event_id_user_id_unique;
+CREATE INDEX IF NOT EXISTS idx_participants_event_id_user_id ON participants(event_id, user_id);
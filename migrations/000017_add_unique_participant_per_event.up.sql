@@ -0,0 +1,9 @@
+DELETE FROM participants p
+USING participants older
+WHERE p.event_id = older.event_id
+  AND p.user_id = older.user_id
+  AND p.id > older.id;
+
+DROP INDEX IF EXISTS idx_participants_event_id_user_id;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_participants_event_id_user_id_unique
+    ON participants(event_id, user_id);
@@ -0,0 +1,7 @@
+ALTER TABLE guild_settings
+    DROP COLUMN IF EXISTS offer_delay_hours;
+
+DROP INDEX IF EXISTS idx_participants_status_offer_expires_at;
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS offer_expires_at;
@@ -0,0 +1,8 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS offer_expires_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_participants_status_offer_expires_at
+    ON participants(status, offer_expires_at);
+
+ALTER TABLE guild_settings
+    ADD COLUMN IF NOT EXISTS offer_delay_hours INTEGER NOT NULL DEFAULT 12;
@@ -0,0 +1,8 @@
+ALTER TABLE event_series
+    DROP COLUMN IF EXISTS max_guests;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS max_guests;
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS guests;
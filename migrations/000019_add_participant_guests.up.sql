@@ -0,0 +1,8 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS guests INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS max_guests INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE event_series
+    ADD COLUMN IF NOT EXISTS max_guests INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,10 @@
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS priority;
+
+ALTER TABLE event_series
+    DROP COLUMN IF EXISTS priority_role_ids,
+    DROP COLUMN IF EXISTS allowed_role_ids;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS priority_role_ids,
+    DROP COLUMN IF EXISTS allowed_role_ids;
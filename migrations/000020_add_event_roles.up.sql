@@ -0,0 +1,10 @@
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS allowed_role_ids TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS priority_role_ids TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE event_series
+    ADD COLUMN IF NOT EXISTS allowed_role_ids TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS priority_role_ids TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS priority BOOLEAN NOT NULL DEFAULT FALSE;
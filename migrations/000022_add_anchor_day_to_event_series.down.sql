@@ -0,0 +1,2 @@
+ALTER TABLE event_series
+    DROP COLUMN IF EXISTS anchor_day;
@@ -0,0 +1,9 @@
+ALTER TABLE event_series
+    ADD COLUMN IF NOT EXISTS anchor_day INT NOT NULL DEFAULT 0;
+
+UPDATE event_series
+SET anchor_day = EXTRACT(DAY FROM COALESCE(
+        (SELECT MIN(events.scheduled_at) FROM events WHERE events.series_id = event_series.id),
+        event_series.next_at
+    ) AT TIME ZONE event_series.timezone)
+WHERE recurrence = 'MONTHLY' AND anchor_day = 0;
@@ -2,34 +2,422 @@ package discord
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"servbot/internal/domain"
-	"servbot/pkg/tz"
 )
 
-// ParseEventDateTime parses date (JJ/MM/AAAA) + time (HH:MM) in Europe/Paris.
-// Returns an error if format is invalid or date/time is in the past.
-func ParseEventDateTime(dateStr, timeStr string) (time.Time, error) {
-	dateStr = strings.TrimSpace(dateStr)
-	timeStr = strings.TrimSpace(timeStr)
-	if dateStr == "" || timeStr == "" {
-		return time.Time{}, fmt.Errorf("date et heure requises (JJ/MM/AAAA et HH:MM)")
-	}
-	tDate, err := time.Parse("02/01/2006", dateStr)
-	if err != nil {
-		return time.Time{}, fmt.Errorf("date invalide (attendu JJ/MM/AAAA, ex: 15/03/2026)")
-	}
-	tTime, err := time.Parse("15:04", timeStr)
-	if err != nil {
-		return time.Time{}, fmt.Errorf("heure invalide (attendu HH:MM, ex: 14:00)")
-	}
-	dt := time.Date(tDate.Year(), tDate.Month(), tDate.Day(),
-		tTime.Hour(), tTime.Minute(), 0, 0, tz.Paris)
-	now := time.Now().In(tz.Paris)
+var (
+	weekdays = map[string]time.Weekday{
+		"lundi": time.Monday, "mardi": time.Tuesday, "mercredi": time.Wednesday, "jeudi": time.Thursday,
+		"vendredi": time.Friday, "samedi": time.Saturday, "dimanche": time.Sunday,
+		"monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday, "thursday": time.Thursday,
+		"friday": time.Friday, "saturday": time.Saturday, "sunday": time.Sunday,
+		"mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday, "thu": time.Thursday,
+		"fri": time.Friday, "sat": time.Saturday, "sun": time.Sunday,
+	}
+	months = map[string]time.Month{
+		"janvier": time.January, "fevrier": time.February, "février": time.February, "mars": time.March,
+		"avril": time.April, "mai": time.May, "juin": time.June, "juillet": time.July, "aout": time.August,
+		"août": time.August, "septembre": time.September, "octobre": time.October, "novembre": time.November,
+		"decembre": time.December, "décembre": time.December,
+		"january": time.January, "february": time.February, "march": time.March, "april": time.April,
+		"may": time.May, "june": time.June, "july": time.July, "august": time.August,
+		"september": time.September, "october": time.October, "november": time.November, "december": time.December,
+	}
+	relativeDays = map[string]int{
+		"aujourdhui": 0, "today": 0, "ce-soir": 0, "tonight": 0,
+		"demain": 1, "tomorrow": 1,
+		"apres-demain": 2,
+	}
+	// Words that carry no information ("samedi prochain à 19h", "next saturday at 7pm").
+	fillerWords = map[string]bool{
+		"à": true, "a": true, "at": true, "le": true, "on": true, "the": true,
+		"prochain": true, "prochaine": true, "next": true, "ce": true, "cette": true, "this": true,
+	}
+
+	phraseReplacer = strings.NewReplacer(
+		"aujourd'hui", "aujourdhui", "aujourd’hui", "aujourdhui",
+		"après-demain", "apres-demain", "après demain", "apres-demain", "apres demain", "apres-demain",
+		"day after tomorrow", "apres-demain",
+		"ce soir", "ce-soir",
+		",", " ",
+	)
+	spacedMeridiemRe = regexp.MustCompile(`\b(\d{1,2}(?::\d{2})?)\s+(am|pm)\b`)
+	inDaysRe         = regexp.MustCompile(`\b(?:dans|in)\s+(\d{1,3})\s+(jours?|days?|semaines?|weeks?)\b`)
+	isoDateRe        = regexp.MustCompile(`^(\d{4})-(\d{1,2})-(\d{1,2})$`)
+	numericDateRe    = regexp.MustCompile(`^(\d{1,2})[/.-](\d{1,2})(?:[/.-](\d{4}|\d{2}))?$`)
+	clockRe          = regexp.MustCompile(`^(\d{1,2})(?:([:h])(\d{2})?)?(am|pm)?$`)
+	dayOfMonthRe     = regexp.MustCompile(`^(\d{1,2})(?:er|st|nd|rd|th)?$`)
+	yearRe           = regexp.MustCompile(`^\d{4}$`)
+)
+
+// whenParts collects what was found in the input before the date is resolved.
+type whenParts struct {
+	year, day    int
+	month        time.Month
+	hasDate      bool
+	yearGiven    bool
+	offsetDays   int
+	hasOffset    bool
+	weekday      time.Weekday
+	hasWeekday   bool
+	hour, minute int
+	hasTime      bool
+}
+
+// ParseEventDateTime parses when an event takes place, in loc. It accepts:
+//   - dates: 15/03/2026, 15/03/26, 15/03, 2026-03-15, 15 mars, march 15th 2026;
+//   - relative days: aujourd'hui, demain, après-demain, today, tomorrow, samedi, next friday, dans 3 jours, in 2 weeks;
+//   - times: 19:30, 19h30, 19h, 7pm, 7:30 pm, midi, noon, minuit, midnight.
+//
+// A time is required; without a date the event is today. A date without a year is the next
+// one to come. Returns domain.ErrInvalidDateTime when the input is not understood and
+// domain.ErrDateTimeInPast when it resolves to the past.
+func ParseEventDateTime(input string, loc *time.Location) (time.Time, error) {
+	return parseEventDateTime(input, time.Now(), loc)
+}
+
+func parseEventDateTime(input string, now time.Time, loc *time.Location) (time.Time, error) {
+	now = now.In(loc)
+	s := phraseReplacer.Replace(strings.ToLower(strings.TrimSpace(input)))
+	s = spacedMeridiemRe.ReplaceAllString(s, "$1$2")
+	s = inDaysRe.ReplaceAllStringFunc(s, func(m string) string {
+		sub := inDaysRe.FindStringSubmatch(m)
+		n, _ := strconv.Atoi(sub[1])
+		if strings.HasPrefix(sub[2], "semaine") || strings.HasPrefix(sub[2], "week") {
+			n *= 7
+		}
+		return fmt.Sprintf("+%dd", n)
+	})
+
+	var p whenParts
+	tokens := strings.Fields(s)
+	for k := 0; k < len(tokens); k++ {
+		tok := tokens[k]
+		if fillerWords[tok] {
+			continue
+		}
+		if n, ok := relativeDays[tok]; ok {
+			if !p.setOffset(n) {
+				return time.Time{}, domain.ErrInvalidDateTime
+			}
+			continue
+		}
+		if wd, ok := weekdays[tok]; ok {
+			if p.hasDate || p.hasOffset || p.hasWeekday {
+				return time.Time{}, domain.ErrInvalidDateTime
+			}
+			p.weekday, p.hasWeekday = wd, true
+			continue
+		}
+		if rest, ok := strings.CutPrefix(tok, "+"); ok {
+			n, err := strconv.Atoi(strings.TrimSuffix(rest, "d"))
+			if err != nil || !p.setOffset(n) {
+				return time.Time{}, domain.ErrInvalidDateTime
+			}
+			continue
+		}
+		if consumed, ok := p.parseDate(tokens[k:]); ok {
+			k += consumed - 1
+			continue
+		}
+		if p.parseClock(tok) {
+			continue
+		}
+		return time.Time{}, domain.ErrInvalidDateTime
+	}
+	if !p.hasTime {
+		return time.Time{}, domain.ErrInvalidDateTime
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	at := func(day time.Time) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day(), p.hour, p.minute, 0, 0, loc)
+	}
+	var dt time.Time
+	switch {
+	case p.hasDate:
+		year := p.year
+		if !p.yearGiven {
+			year = today.Year()
+		}
+		day := time.Date(year, p.month, p.day, 0, 0, 0, 0, loc)
+		if day.Month() != p.month || day.Day() != p.day {
+			return time.Time{}, domain.ErrInvalidDateTime
+		}
+		if !p.yearGiven && day.Before(today) {
+			day = time.Date(year+1, p.month, p.day, 0, 0, 0, 0, loc)
+		}
+		dt = at(day)
+	case p.hasWeekday:
+		delta := (int(p.weekday) - int(today.Weekday()) + 7) % 7
+		dt = at(today.AddDate(0, 0, delta))
+		if delta == 0 && !dt.After(now) {
+			dt = at(today.AddDate(0, 0, 7))
+		}
+	default:
+		dt = at(today.AddDate(0, 0, p.offsetDays))
+	}
 	if dt.Before(now.Add(-time.Minute)) {
 		return time.Time{}, domain.ErrDateTimeInPast
 	}
 	return dt, nil
 }
+
+func (p *whenParts) setOffset(days int) bool {
+	if p.hasDate || p.hasOffset || p.hasWeekday {
+		return false
+	}
+	p.offsetDays, p.hasOffset = days, true
+	return true
+}
+
+// parseDate reads a date starting at tokens[0] and returns the number of tokens it used.
+func (p *whenParts) parseDate(tokens []string) (int, bool) {
+	if p.hasDate || p.hasOffset || p.hasWeekday {
+		return 0, false
+	}
+	tok := tokens[0]
+	if m := isoDateRe.FindStringSubmatch(tok); m != nil {
+		p.setDate(atoi(m[3]), time.Month(atoi(m[2])), atoi(m[1]))
+		return 1, true
+	}
+	if m := numericDateRe.FindStringSubmatch(tok); m != nil {
+		year := 0
+		if m[3] != "" {
+			year = atoi(m[3])
+			if year < 100 {
+				year += 2000
+			}
+		}
+		p.setDate(atoi(m[1]), time.Month(atoi(m[2])), year)
+		return 1, true
+	}
+	// "15 mars [2026]"
+	if m := dayOfMonthRe.FindStringSubmatch(tok); m != nil && len(tokens) > 1 {
+		if month, ok := months[tokens[1]]; ok {
+			used, year := 2, 0
+			if len(tokens) > 2 && yearRe.MatchString(tokens[2]) {
+				used, year = 3, atoi(tokens[2])
+			}
+			p.setDate(atoi(m[1]), month, year)
+			return used, true
+		}
+	}
+	// "march 15[th] [2026]"
+	if month, ok := months[tok]; ok && len(tokens) > 1 {
+		if m := dayOfMonthRe.FindStringSubmatch(tokens[1]); m != nil {
+			used, year := 2, 0
+			if len(tokens) > 2 && yearRe.MatchString(tokens[2]) {
+				used, year = 3, atoi(tokens[2])
+			}
+			p.setDate(atoi(m[1]), month, year)
+			return used, true
+		}
+	}
+	return 0, false
+}
+
+func (p *whenParts) setDate(day int, month time.Month, year int) {
+	p.day, p.month, p.year = day, month, year
+	p.yearGiven = year != 0
+	p.hasDate = true
+}
+
+// parseClock reads "19:30", "19h30", "19h", "7pm", "7:30pm", "midi"… A bare number is not a time.
+func (p *whenParts) parseClock(tok string) bool {
+	if p.hasTime {
+		return false
+	}
+	switch tok {
+	case "midi", "noon":
+		p.hour, p.minute, p.hasTime = 12, 0, true
+		return true
+	case "minuit", "midnight":
+		p.hour, p.minute, p.hasTime = 0, 0, true
+		return true
+	}
+	m := clockRe.FindStringSubmatch(tok)
+	if m == nil || (m[2] == "" && m[4] == "") || (m[2] == ":" && m[3] == "") {
+		return false
+	}
+	hour, minute := atoi(m[1]), 0
+	if m[3] != "" {
+		minute = atoi(m[3])
+	}
+	switch m[4] {
+	case "am", "pm":
+		if hour < 1 || hour > 12 {
+			return false
+		}
+		hour %= 12
+		if m[4] == "pm" {
+			hour += 12
+		}
+	}
+	if hour > 23 || minute > 59 {
+		return false
+	}
+	p.hour, p.minute, p.hasTime = hour, minute, true
+	return true
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+var (
+	endPrefixes      = []string{"jusqu'à", "jusqu’à", "jusqu'a", "jusqu’a", "until", "till", "fin", "->", "→", "-"}
+	durationPrefixes = []string{"pendant", "durée", "duree", "for", "+"}
+	// Separators between the start and the end in a single "when" input, kept as the end's prefix.
+	endSeparators = []string{"→", "->", " jusqu'à ", " jusqu’à ", " jusqu'a ", " until ", " till ", " pendant ", " durée ", " for ", " +"}
+	// "19h-23h", "18:00 - 22:00", "7pm-11pm": a hyphen right after a time.
+	timeRangeRe     = regexp.MustCompile(`^(.*\d(?:h\d{0,2}|:\d{2}|am|pm))\s*-\s*(\S.*)$`)
+	hoursDurationRe = regexp.MustCompile(`^(\d{1,3})\s*(?:h|hrs?|heures?|hours?)\s*(?:(\d{1,2})\s*(?:m|mins?|minutes?)?)?$`)
+	minDurationRe   = regexp.MustCompile(`^(\d{1,4})\s*(?:m|mins?|minutes?)$`)
+)
+
+// maxEventDuration bounds the end of an event.
+const maxEventDuration = 7 * 24 * time.Hour
+
+// ParseEventWhen parses a start as ParseEventDateTime, optionally followed by its end as
+// ParseEventEnd: "samedi 19h-23h", "demain 19h → 1h", "15/03 20h pendant 2h", "friday 7pm for 3h".
+// end is zero when no end is given.
+func ParseEventWhen(input string, loc *time.Location) (start, end time.Time, err error) {
+	startStr, endStr := splitEventEnd(input)
+	if start, err = ParseEventDateTime(startStr, loc); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if end, err = ParseEventEnd(endStr, start); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, end, nil
+}
+
+// splitEventEnd cuts input before its end part; the separator stays at the start of the end.
+func splitEventEnd(input string) (start, end string) {
+	s := spacedMeridiemRe.ReplaceAllString(strings.ToLower(strings.TrimSpace(input)), "$1$2")
+	for _, sep := range endSeparators {
+		if k := strings.Index(s, sep); k >= 0 {
+			return s[:k], s[k:]
+		}
+	}
+	if m := timeRangeRe.FindStringSubmatch(s); m != nil {
+		return m[1], "- " + m[2]
+	}
+	return s, ""
+}
+
+// ParseEventEnd parses the optional end of an event starting at start, given either as an end
+// time ("23h", "23:30", "jusqu'à 1h", "until 11pm") or as a duration ("2h", "1h30", "90 min",
+// "pendant 3h"). A bare "23h" is an end time when it falls later on the start day, a duration
+// otherwise. An end time earlier than start is on the next day. Returns the zero time for an
+// empty input and domain.ErrInvalidEndTime when the input is not understood.
+func ParseEventEnd(input string, start time.Time) (time.Time, error) {
+	s := strings.ToLower(strings.TrimSpace(input))
+	if s == "" {
+		return time.Time{}, nil
+	}
+	s = spacedMeridiemRe.ReplaceAllString(s, "$1$2")
+	forceEnd, forceDuration := false, false
+	for _, prefix := range endPrefixes {
+		if rest, ok := strings.CutPrefix(s, prefix); ok {
+			s, forceEnd = strings.TrimSpace(rest), true
+			break
+		}
+	}
+	for _, prefix := range durationPrefixes {
+		if rest, ok := strings.CutPrefix(s, prefix); ok && !forceEnd {
+			s, forceDuration = strings.TrimSpace(rest), true
+			break
+		}
+	}
+
+	var end time.Time
+	var ok bool
+	switch {
+	case forceEnd:
+		end, ok = endClock(s, start)
+	case forceDuration:
+		var d time.Duration
+		d, ok = parseDuration(s)
+		end = start.Add(d)
+	default:
+		end, ok = endClock(s, start)
+		if d, isDuration := parseDuration(s); isDuration && (!ok || end.Day() != start.Day()) {
+			end, ok = start.Add(d), true
+		}
+	}
+	if !ok || !end.After(start) || end.Sub(start) > maxEventDuration {
+		return time.Time{}, domain.ErrInvalidEndTime
+	}
+	return end, nil
+}
+
+// endClock returns the first time at the given clock after start, in start's location.
+func endClock(s string, start time.Time) (time.Time, bool) {
+	var p whenParts
+	if !p.parseClock(s) {
+		return time.Time{}, false
+	}
+	end := time.Date(start.Year(), start.Month(), start.Day(), p.hour, p.minute, 0, 0, start.Location())
+	if !end.After(start) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return end, true
+}
+
+func parseDuration(s string) (time.Duration, bool) {
+	if m := hoursDurationRe.FindStringSubmatch(s); m != nil {
+		d := time.Duration(atoi(m[1])) * time.Hour
+		if m[2] != "" {
+			d += time.Duration(atoi(m[2])) * time.Minute
+		}
+		return d, d > 0
+	}
+	if m := minDurationRe.FindStringSubmatch(s); m != nil {
+		d := time.Duration(atoi(m[1])) * time.Minute
+		return d, d > 0
+	}
+	return 0, false
+}
+
+// FormatEventWhen renders start and end in loc so that ParseEventWhen reads them back.
+func FormatEventWhen(start, end time.Time, loc *time.Location) string {
+	start = start.In(loc)
+	when := start.Format("02/01/2006 15:04")
+	if end.IsZero() {
+		return when
+	}
+	d := end.Sub(start)
+	switch {
+	case d < 24*time.Hour:
+		return when + " → " + end.In(loc).Format("15:04")
+	case d%time.Hour != 0:
+		return fmt.Sprintf("%s +%dh%02d", when, int(d/time.Hour), int(d%time.Hour/time.Minute))
+	default:
+		return fmt.Sprintf("%s +%dh", when, int(d/time.Hour))
+	}
+}
+
+// FormatTimestamp renders t with Discord's timestamp markup, shown in each viewer's own timezone.
+func FormatTimestamp(t time.Time) string {
+	return fmt.Sprintf("<t:%d:F>", t.Unix())
+}
+
+// FormatTimestampRange renders start with its end when one is given, the end day being
+// repeated only for events longer than a day.
+func FormatTimestampRange(start, end time.Time) string {
+	if end.IsZero() {
+		return FormatTimestamp(start)
+	}
+	if end.Sub(start) < 24*time.Hour {
+		return fmt.Sprintf("%s → <t:%d:t>", FormatTimestamp(start), end.Unix())
+	}
+	return fmt.Sprintf("%s → %s", FormatTimestamp(start), FormatTimestamp(end))
+}
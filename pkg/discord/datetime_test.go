@@ -0,0 +1,182 @@
+package discord
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"servbot/internal/domain"
+)
+
+// testLoc is fixed so that the tests do not depend on the tz database of the machine.
+var testLoc = time.FixedZone("UTC+2", 2*60*60)
+
+// testNow is Wednesday 14 October 2026, 10:00.
+var testNow = time.Date(2026, time.October, 14, 10, 0, 0, 0, testLoc)
+
+func at(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, testLoc)
+}
+
+func TestParseEventDateTime(t *testing.T) {
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		// Relative days.
+		{"demain 19h", at(2026, time.October, 15, 19, 0)},
+		{"tomorrow 7pm", at(2026, time.October, 15, 19, 0)},
+		{"tomorrow 7:30 pm", at(2026, time.October, 15, 19, 30)},
+		{"aujourd'hui 18h", at(2026, time.October, 14, 18, 0)},
+		{"ce soir 21h", at(2026, time.October, 14, 21, 0)},
+		{"après-demain midi", at(2026, time.October, 16, 12, 0)},
+		{"dans 3 jours 20h", at(2026, time.October, 17, 20, 0)},
+		{"in 2 weeks 18:00", at(2026, time.October, 28, 18, 0)},
+		// Weekdays: the next one to come, next week when today's time has passed.
+		{"samedi 14:30", at(2026, time.October, 17, 14, 30)},
+		{"samedi prochain à 19h", at(2026, time.October, 17, 19, 0)},
+		{"next friday at 8pm", at(2026, time.October, 16, 20, 0)},
+		{"mercredi 18h", at(2026, time.October, 14, 18, 0)},
+		{"mercredi 9h", at(2026, time.October, 21, 9, 0)},
+		// Dates without a year: this year, or next year once passed.
+		{"20/10 20h", at(2026, time.October, 20, 20, 0)},
+		{"15/03 20h", at(2027, time.March, 15, 20, 0)},
+		{"15/03 19h30", at(2027, time.March, 15, 19, 30)},
+		{"15 mars 20h", at(2027, time.March, 15, 20, 0)},
+		{"march 15th 8pm", at(2027, time.March, 15, 20, 0)},
+		// Dates with a year.
+		{"2026-12-15 18:00", at(2026, time.December, 15, 18, 0)},
+		{"15/12/2026 18:00", at(2026, time.December, 15, 18, 0)},
+		{"15/12/26 18h", at(2026, time.December, 15, 18, 0)},
+		{"15 décembre 2026 18h", at(2026, time.December, 15, 18, 0)},
+		// Time only: today.
+		{"19h", at(2026, time.October, 14, 19, 0)},
+		{"19h30", at(2026, time.October, 14, 19, 30)},
+		{"19:30", at(2026, time.October, 14, 19, 30)},
+		{"7pm", at(2026, time.October, 14, 19, 0)},
+		{"midi", at(2026, time.October, 14, 12, 0)},
+		{"  Demain  19H ", at(2026, time.October, 15, 19, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseEventDateTime(tt.input, testNow, testLoc)
+			if err != nil {
+				t.Fatalf("parseEventDateTime(%q) error: %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseEventDateTime(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseEventDateTimeErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  error
+	}{
+		{"2026-03-15 18:00", domain.ErrDateTimeInPast},
+		{"15/03/2026 18h", domain.ErrDateTimeInPast},
+		{"aujourd'hui 9h", domain.ErrDateTimeInPast},
+		{"9h", domain.ErrDateTimeInPast},
+		{"", domain.ErrInvalidDateTime},
+		{"demain", domain.ErrInvalidDateTime},
+		{"15/03", domain.ErrInvalidDateTime},
+		{"2026-03-15", domain.ErrInvalidDateTime},
+		{"n'importe quand", domain.ErrInvalidDateTime},
+		{"demain 25h", domain.ErrInvalidDateTime},
+		{"demain 19h75", domain.ErrInvalidDateTime},
+		{"demain 13pm", domain.ErrInvalidDateTime},
+		{"31/02 19h", domain.ErrInvalidDateTime},
+		{"32/01 19h", domain.ErrInvalidDateTime},
+		{"samedi demain 19h", domain.ErrInvalidDateTime},
+		{"demain 19h 20h", domain.ErrInvalidDateTime},
+		{"demain 19", domain.ErrInvalidDateTime},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseEventDateTime(tt.input, testNow, testLoc)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("parseEventDateTime(%q) = %v, %v; want error %v", tt.input, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseEventEnd(t *testing.T) {
+	start := at(2026, time.October, 17, 19, 0)
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"", time.Time{}},
+		{"23h", at(2026, time.October, 17, 23, 0)},
+		{"23:30", at(2026, time.October, 17, 23, 30)},
+		{"11pm", at(2026, time.October, 17, 23, 0)},
+		{"jusqu'à 1h", at(2026, time.October, 18, 1, 0)},
+		{"until 2am", at(2026, time.October, 18, 2, 0)},
+		{"2h", at(2026, time.October, 17, 21, 0)},
+		{"1h30", at(2026, time.October, 17, 20, 30)},
+		{"90 min", at(2026, time.October, 17, 20, 30)},
+		{"pendant 3h", at(2026, time.October, 17, 22, 0)},
+		{"for 48h", at(2026, time.October, 19, 19, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseEventEnd(tt.input, start)
+			if err != nil {
+				t.Fatalf("ParseEventEnd(%q) error: %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseEventEnd(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	for _, input := range []string{"bientôt", "pendant 0h", "200h", "jusqu'à 25h"} {
+		t.Run(input, func(t *testing.T) {
+			if got, err := ParseEventEnd(input, start); !errors.Is(err, domain.ErrInvalidEndTime) {
+				t.Errorf("ParseEventEnd(%q) = %v, %v; want error %v", input, got, err, domain.ErrInvalidEndTime)
+			}
+		})
+	}
+}
+
+func TestParseEventWhen(t *testing.T) {
+	// Dates far in the future so that the real clock used by ParseEventWhen does not matter.
+	tests := []struct {
+		input      string
+		start, end time.Time
+	}{
+		{"2099-03-15 19h", at(2099, time.March, 15, 19, 0), time.Time{}},
+		{"2099-03-15 19h-23h", at(2099, time.March, 15, 19, 0), at(2099, time.March, 15, 23, 0)},
+		{"15/03/2099 20h → 1h", at(2099, time.March, 15, 20, 0), at(2099, time.March, 16, 1, 0)},
+		{"15/03/2099 20h pendant 2h", at(2099, time.March, 15, 20, 0), at(2099, time.March, 15, 22, 0)},
+		{"march 15 2099 7pm for 3h", at(2099, time.March, 15, 19, 0), at(2099, time.March, 15, 22, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			start, end, err := ParseEventWhen(tt.input, testLoc)
+			if err != nil {
+				t.Fatalf("ParseEventWhen(%q) error: %v", tt.input, err)
+			}
+			if !start.Equal(tt.start) || !end.Equal(tt.end) {
+				t.Errorf("ParseEventWhen(%q) = %v, %v; want %v, %v", tt.input, start, end, tt.start, tt.end)
+			}
+		})
+	}
+
+	if _, _, err := ParseEventWhen("2099-03-15 19h-bientôt", testLoc); !errors.Is(err, domain.ErrInvalidEndTime) {
+		t.Errorf("ParseEventWhen with an invalid end: error %v, want %v", err, domain.ErrInvalidEndTime)
+	}
+}
+
+func TestFormatEventWhenRoundTrip(t *testing.T) {
+	start := at(2099, time.March, 15, 20, 0)
+	for _, end := range []time.Time{{}, start.Add(3 * time.Hour), start.Add(26*time.Hour + 30*time.Minute)} {
+		gotStart, gotEnd, err := ParseEventWhen(FormatEventWhen(start, end, testLoc), testLoc)
+		if err != nil || !gotStart.Equal(start) || !gotEnd.Equal(end) {
+			t.Errorf("round trip of %v → %v: got %v → %v, %v", start, end, gotStart, gotEnd, err)
+		}
+	}
+}
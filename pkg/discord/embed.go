@@ -2,75 +2,161 @@ package discord
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
 	"servbot/internal/domain"
 	"servbot/internal/domain/entities"
-	"servbot/pkg/tz"
+	"servbot/internal/ports/output"
 
 	"github.com/bwmarrin/discordgo"
 )
 
 const (
-	embedColor = 0x5865F2
-	embedTitle = "📅 Détails de la sortie"
+	embedColor          = 0x5865F2
+	embedCancelledColor = 0xED4245
+	faqMaxLength        = 4000
 )
 
-func formatPlaces(maxSlots, confirmedCount int) string {
+// formatPlaces renders the seats taken, participants and their guests, out of maxSlots.
+func formatPlaces(t output.T, locale string, maxSlots, takenSeats int) string {
 	if maxSlots == 0 {
-		return fmt.Sprintf("%d (Illimité)", confirmedCount)
+		return t.T(locale, "embed.places_unlimited", map[string]any{"Count": takenSeats})
 	}
-	return fmt.Sprintf("%d/%d", confirmedCount, maxSlots)
+	return fmt.Sprintf("%d/%d", takenSeats, maxSlots)
 }
 
-func buildDescriptionBase(organizerMention, description string, scheduledAt time.Time, placesText string, waitlistCount int) string {
+// MapSearchURL links to an OpenStreetMap search for a free-text location.
+func MapSearchURL(location string) string {
+	return "https://www.openstreetmap.org/search?query=" + url.QueryEscape(location)
+}
+
+func buildDescriptionBase(t output.T, locale, organizerMention, description, location string, scheduledAt, endsAt time.Time, placesText string, maxGuests, waitlistCount int) string {
 	var b strings.Builder
-	b.WriteString(fmt.Sprintf("**Organisé par :** %s\n\n", organizerMention))
+	b.WriteString(t.T(locale, "embed.organized_by", map[string]any{"Mention": organizerMention}) + "\n\n")
 	b.WriteString(description)
 	if !scheduledAt.IsZero() {
-		b.WriteString(fmt.Sprintf("\n\n**Quand :** %s", scheduledAt.In(tz.Paris).Format("02/01/2006 à 15:04")))
+		b.WriteString("\n\n" + t.T(locale, "embed.when", map[string]any{"Date": FormatTimestampRange(scheduledAt, endsAt)}))
+	}
+	if location != "" {
+		b.WriteString("\n" + t.T(locale, "embed.where", map[string]any{
+			"Location": strings.NewReplacer("[", "(", "]", ")").Replace(location),
+			"Link":     MapSearchURL(location),
+		}))
 	}
-	b.WriteString(fmt.Sprintf("\n\n**Places :** %s", placesText))
+	b.WriteString("\n\n" + t.T(locale, "embed.places", map[string]any{"Places": placesText}))
 	if waitlistCount > 0 {
-		b.WriteString(fmt.Sprintf(" • %d en attente", waitlistCount))
+		b.WriteString(" • " + t.T(locale, "embed.waitlist_count", map[string]any{"Count": waitlistCount}))
+	}
+	if maxGuests > 0 {
+		b.WriteString("\n" + t.T(locale, "embed.guests_allowed", map[string]any{"Max": maxGuests}))
 	}
 	return b.String()
 }
 
 // BuildNewEventEmbed builds the initial post embed with the organizer
 // already counted as a confirmed participant. Only counters are shown (no public list).
-func BuildNewEventEmbed(creatorID, description string, scheduledAt time.Time, slots int, displayName, avatarURL string) *discordgo.MessageEmbed {
+func BuildNewEventEmbed(t output.T, locale, creatorID, description, location string, scheduledAt, endsAt time.Time, slots, maxGuests int, displayName, avatarURL string) *discordgo.MessageEmbed {
 	userMention := fmt.Sprintf("<@%s>", creatorID)
-	placesText := formatPlaces(slots, 1)
-	desc := buildDescriptionBase(userMention, description, scheduledAt, placesText, 0)
+	placesText := formatPlaces(t, locale, slots, 1)
+	desc := buildDescriptionBase(t, locale, userMention, description, location, scheduledAt, endsAt, placesText, maxGuests, 0)
 	return &discordgo.MessageEmbed{
-		Title:       embedTitle,
+		Title:       t.T(locale, "embed.title", nil),
 		Description: desc,
 		Color:       embedColor,
 		Author:      &discordgo.MessageEmbedAuthor{Name: displayName, IconURL: avatarURL},
-		Footer:      &discordgo.MessageEmbedFooter{Text: "Réagis avec ✅ pour t'inscrire"},
+		Footer:      &discordgo.MessageEmbedFooter{Text: t.T(locale, "embed.footer", nil)},
 	}
 }
 
-// UpdateEventEmbed updates the embed with confirmed/waitlist counts only (no public participant list).
-func UpdateEventEmbed(embed *discordgo.MessageEmbed, event *entities.Event, confirmedCount, waitlistCount int) {
+// UpdateEventEmbed updates the embed with the seats taken and the waitlist count only (no public participant list).
+// Title and footer are rendered again so that a change of the guild language applies to existing posts.
+func UpdateEventEmbed(t output.T, locale string, embed *discordgo.MessageEmbed, event *entities.Event, takenSeats, waitlistCount int) {
 	organizerMention := fmt.Sprintf("<@%s>", event.CreatorID)
-	placesText := formatPlaces(event.MaxSlots, confirmedCount)
-	embed.Description = buildDescriptionBase(organizerMention, event.Description, event.ScheduledAt, placesText, waitlistCount)
+	placesText := formatPlaces(t, locale, event.MaxSlots, takenSeats)
+	embed.Description = buildDescriptionBase(t, locale, organizerMention, event.Description, event.Location, event.ScheduledAt, event.EndsAt, placesText, event.MaxGuests, waitlistCount)
+	if len(event.CoHostIDs) > 0 {
+		embed.Description += "\n" + t.T(locale, "embed.cohosts", map[string]any{"Mentions": userMentions(event.CoHostIDs)})
+	}
+	if len(event.AllowedRoleIDs) > 0 {
+		embed.Description += "\n" + t.T(locale, "embed.roles_allowed", map[string]any{"Roles": roleMentions(event.AllowedRoleIDs)})
+	}
+	if len(event.PriorityRoleIDs) > 0 {
+		embed.Description += "\n" + t.T(locale, "embed.roles_priority", map[string]any{"Roles": roleMentions(event.PriorityRoleIDs)})
+	}
+	embed.Title = t.T(locale, "embed.title", nil)
+	embed.Footer = &discordgo.MessageEmbedFooter{Text: t.T(locale, "embed.footer", nil)}
+	if event.HasEnded() {
+		embed.Footer = &discordgo.MessageEmbedFooter{Text: t.T(locale, "embed.ended_footer", nil)}
+	}
+	if event.IsCancelled() {
+		embed.Title = t.T(locale, "embed.cancelled_title", nil)
+		embed.Color = embedCancelledColor
+		embed.Description += "\n\n" + t.T(locale, "embed.cancel_reason", map[string]any{"Reason": event.CancelReason})
+		embed.Footer = &discordgo.MessageEmbedFooter{Text: t.T(locale, "embed.cancelled_footer", nil)}
+	}
+}
+
+func userMentions(userIDs []string) string {
+	mentions := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		mentions[i] = "<@" + id + ">"
+	}
+	return strings.Join(mentions, ", ")
 }
 
+// roleMentions renders role IDs as mentions; embeds show them without pinging the roles.
+func roleMentions(roleIDs []string) string {
+	mentions := make([]string, len(roleIDs))
+	for i, id := range roleIDs {
+		mentions[i] = "<@&" + id + ">"
+	}
+	return strings.Join(mentions, ", ")
+}
+
+// BuildFAQEmbed lists the published questions with their answers, oldest first.
+// Entries that no longer fit in the embed description are left out.
+func BuildFAQEmbed(t output.T, locale string, questions []entities.Question) *discordgo.MessageEmbed {
+	var b strings.Builder
+	for _, q := range questions {
+		entry := t.T(locale, "embed.faq_entry", map[string]any{"Question": q.Text, "Answer": q.Answer}) + "\n\n"
+		if b.Len()+len(entry) > faqMaxLength {
+			b.WriteString("…")
+			break
+		}
+		b.WriteString(entry)
+	}
+	return &discordgo.MessageEmbed{
+		Title:       t.T(locale, "embed.faq_title", nil),
+		Description: strings.TrimSpace(b.String()),
+		Color:       embedColor,
+	}
+}
+
+// FormatParticipants lists the confirmed participants and the waitlist, members with a
+// priority role first and marked ⭐.
 func FormatParticipants(participants []entities.Participant) (confirmed, waitlist []string) {
 	confirmed = make([]string, 0, len(participants))
 	waitlist = make([]string, 0, len(participants))
+	var regular []string
 	for _, p := range participants {
 		mention := fmt.Sprintf("<@%s>", p.UserID)
+		if p.Guests > 0 {
+			mention += fmt.Sprintf(" (+%d)", p.Guests)
+		}
 		switch p.Status {
 		case domain.StatusConfirmed:
 			confirmed = append(confirmed, "- "+mention)
+		case domain.StatusOffered:
+			waitlist = append(waitlist, "- "+mention+" ⏳")
 		case domain.StatusWaitlist:
-			waitlist = append(waitlist, "- "+mention)
+			if p.Priority {
+				waitlist = append(waitlist, "- "+mention+" ⭐")
+			} else {
+				regular = append(regular, "- "+mention)
+			}
 		}
 	}
-	return confirmed, waitlist
+	return confirmed, append(waitlist, regular...)
 }
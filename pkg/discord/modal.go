@@ -1,28 +1,29 @@
 package discord
 
-import "github.com/bwmarrin/discordgo"
+import (
+	"strings"
 
-// Order: 5 rows = title, desc, date, time, slots; 3 rows (legacy) = title, desc, slots.
-func ExtractModalData(data discordgo.ModalSubmitInteractionData) (title, desc, dateStr, timeStr, slotsStr string) {
-	get := func(i int) string {
-		if i >= len(data.Components) {
-			return ""
+	"github.com/bwmarrin/discordgo"
+)
+
+// ExtractModalData reads the event modal inputs by CustomID. Modals opened before the date and
+// time fields were merged still submit "date" and "time"; they are joined into when.
+func ExtractModalData(data discordgo.ModalSubmitInteractionData) (title, desc, when, location, slotsStr string) {
+	values := make(map[string]string, len(data.Components))
+	for _, c := range data.Components {
+		row, ok := c.(*discordgo.ActionsRow)
+		if !ok {
+			continue
 		}
-		if row, ok := data.Components[i].(*discordgo.ActionsRow); ok && len(row.Components) > 0 {
-			if input, ok := row.Components[0].(*discordgo.TextInput); ok {
-				return input.Value
+		for _, rc := range row.Components {
+			if input, ok := rc.(*discordgo.TextInput); ok {
+				values[input.CustomID] = strings.TrimSpace(input.Value)
 			}
 		}
-		return ""
 	}
-	title = get(0)
-	desc = get(1)
-	if len(data.Components) >= 5 {
-		dateStr = get(2)
-		timeStr = get(3)
-		slotsStr = get(4)
-	} else if len(data.Components) >= 3 {
-		slotsStr = get(2)
+	when = values["when"]
+	if when == "" {
+		when = strings.TrimSpace(values["date"] + " " + values["time"])
 	}
-	return
+	return values["title"], values["desc"], when, values["location"], values["slots"]
 }
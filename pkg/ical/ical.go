@@ -0,0 +1,119 @@
+// Package ical renders events as an iCalendar (RFC 5545) document.
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"servbot/internal/domain/entities"
+)
+
+const (
+	ContentType = "text/calendar; charset=utf-8"
+	prodID      = "-//servBot//Sorties//FR"
+	maxLineLen  = 75 // octets, CRLF excluded
+	dateTimeFmt = "20060102T150405Z"
+)
+
+// Calendar returns a VCALENDAR holding one VEVENT per event with a date.
+// Times are written in UTC so that every client shows them in its own zone.
+func Calendar(name string, events []entities.Event) string {
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:"+prodID)
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	if name != "" {
+		writeLine(&b, "X-WR-CALNAME:"+escapeText(name))
+	}
+	now := time.Now()
+	for i := range events {
+		writeEvent(&b, &events[i], now)
+	}
+	writeLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+// FileName is the attachment name of the .ics of a single event.
+func FileName(event *entities.Event) string {
+	return fmt.Sprintf("sortie-%d.ics", event.ID)
+}
+
+func writeEvent(b *strings.Builder, e *entities.Event, now time.Time) {
+	if e.ScheduledAt.IsZero() {
+		return
+	}
+	writeLine(b, "BEGIN:VEVENT")
+	writeLine(b, fmt.Sprintf("UID:event-%d@servbot", e.ID))
+	stamp := e.UpdatedAt
+	if stamp.IsZero() {
+		stamp = now
+	}
+	writeLine(b, "DTSTAMP:"+formatTime(stamp))
+	writeLine(b, "DTSTART:"+formatTime(e.ScheduledAt))
+	writeLine(b, "DTEND:"+formatTime(e.EndTime()))
+	writeLine(b, "SUMMARY:"+escapeText(e.Title))
+	if e.Description != "" {
+		writeLine(b, "DESCRIPTION:"+escapeText(e.Description))
+	}
+	if e.Location != "" {
+		writeLine(b, "LOCATION:"+escapeText(e.Location))
+	}
+	if url := messageURL(e); url != "" {
+		writeLine(b, "URL:"+url)
+	}
+	if e.IsCancelled() {
+		writeLine(b, "STATUS:CANCELLED")
+	} else {
+		writeLine(b, "STATUS:CONFIRMED")
+	}
+	writeLine(b, "END:VEVENT")
+}
+
+func messageURL(e *entities.Event) string {
+	if e.GuildID == "" || e.ChannelID == "" || e.MessageID == "" {
+		return ""
+	}
+	return fmt.Sprintf("https://discord.com/channels/%s/%s/%s", e.GuildID, e.ChannelID, e.MessageID)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(dateTimeFmt)
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", "",
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// writeLine folds the content line at 75 octets without splitting a UTF-8 sequence.
+// Continuation lines start with a space, which counts toward their length.
+func writeLine(b *strings.Builder, line string) {
+	limit := maxLineLen
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = maxLineLen - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func isRuneStart(c byte) bool {
+	return c&0xC0 != 0x80
+}
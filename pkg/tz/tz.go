@@ -1,14 +1,50 @@
 package tz
 
-import "time"
+import (
+	"strings"
+	"sync"
+	"time"
+)
 
-// Paris is the Europe/Paris location (CET/CEST with automatic DST).
-var Paris *time.Location
+// DefaultName is the timezone of events created before guilds could choose one.
+const DefaultName = "Europe/Paris"
+
+var (
+	fallback  *time.Location
+	locations sync.Map // name -> *time.Location
+)
 
 func init() {
 	var err error
-	Paris, err = time.LoadLocation("Europe/Paris")
+	fallback, err = time.LoadLocation(DefaultName)
+	if err != nil {
+		panic("tz: load " + DefaultName + ": " + err.Error())
+	}
+}
+
+// Valid reports whether name is an IANA timezone (e.g. America/Montreal) or UTC. The "Local"
+// accepted by time.LoadLocation is rejected: it is the zone of the machine, not of the guild.
+func Valid(name string) bool {
+	if name != "UTC" && !strings.Contains(name, "/") {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
+// Location returns the IANA timezone name (e.g. America/Montreal), or Europe/Paris
+// when name is empty or unknown.
+func Location(name string) *time.Location {
+	if !Valid(name) {
+		return fallback
+	}
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location)
+	}
+	loc, err := time.LoadLocation(name)
 	if err != nil {
-		panic("tz: load Europe/Paris: " + err.Error())
+		return fallback
 	}
+	locations.Store(name, loc)
+	return loc
 }
@@ -0,0 +1,31 @@
+package tz
+
+import "testing"
+
+func TestValid(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"Europe/Paris", true},
+		{"America/Montreal", true},
+		{"America/Argentina/Buenos_Aires", true},
+		{"UTC", true},
+		{"", false},
+		{"Local", false},
+		{"CET", false},
+		{"Europe/Nowhere", false},
+		{"../etc/passwd", false},
+	}
+	for _, tt := range tests {
+		if got := Valid(tt.name); got != tt.want {
+			t.Errorf("Valid(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLocationFallsBackForLocal(t *testing.T) {
+	if got := Location("Local"); got.String() != DefaultName {
+		t.Errorf("Location(%q) = %v, want %v", "Local", got, DefaultName)
+	}
+}
@@ -0,0 +1,10 @@
+-- name: AddEventHost :execrows
+INSERT INTO event_hosts (event_id, user_id, added_by)
+VALUES ($1, $2, $3)
+ON CONFLICT (event_id, user_id) DO NOTHING;
+
+-- name: DeleteEventHost :execrows
+DELETE FROM event_hosts WHERE event_id = $1 AND user_id = $2;
+
+-- name: GetEventHostIDsByEventID :many
+SELECT user_id FROM event_hosts WHERE event_id = $1 ORDER BY created_at, user_id;
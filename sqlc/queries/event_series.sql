@@ -0,0 +1,48 @@
+-- name: CreateEventSeries :one
+INSERT INTO event_series (guild_id, creator_id, recurrence, title, description, location, max_slots, max_guests, allowed_role_ids, priority_role_ids, waitlist_auto, next_at, duration_minutes, timezone, anchor_day)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+RETURNING *;
+
+-- name: GetEventSeriesByID :one
+SELECT * FROM event_series WHERE id = $1;
+
+-- name: UpdateEventSeries :exec
+UPDATE event_series SET
+    title = $2,
+    description = $3,
+    location = $4,
+    max_slots = $5,
+    waitlist_auto = $6,
+    next_at = $7,
+    duration_minutes = $8,
+    max_guests = $9,
+    anchor_day = $10,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: AdvanceEventSeries :execrows
+UPDATE event_series SET next_at = sqlc.arg(next_at), updated_at = NOW()
+WHERE id = sqlc.arg(id) AND next_at = sqlc.arg(previous_next_at);
+
+-- name: UpdateEventSeriesRoles :exec
+UPDATE event_series SET allowed_role_ids = $2, priority_role_ids = $3, updated_at = NOW() WHERE id = $1;
+
+-- name: CancelEventSeries :exec
+UPDATE event_series SET cancelled_at = NOW(), updated_at = NOW() WHERE id = $1;
+
+-- name: FindEventSeriesNeedingNextOccurrence :many
+SELECT * FROM event_series
+WHERE cancelled_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM events
+    WHERE events.series_id = event_series.id
+      AND events.organizer_step1_finalized_at IS NULL
+      AND events.cancelled_at IS NULL
+      AND (events.scheduled_at IS NULL OR events.scheduled_at > $1)
+  );
+
+-- name: DeleteEventSeriesByGuildID :exec
+DELETE FROM event_series WHERE guild_id = $1;
+
+-- name: BackfillEventSeriesGuildID :execrows
+UPDATE event_series SET guild_id = $1 WHERE guild_id = '';
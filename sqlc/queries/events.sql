@@ -1,6 +1,6 @@
 -- name: CreateEvent :one
-INSERT INTO events (message_id, channel_id, creator_id, title, description, max_slots, scheduled_at, private_channel_id, questions_thread_id, waitlist_auto)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+INSERT INTO events (guild_id, message_id, channel_id, creator_id, title, description, location, max_slots, max_guests, allowed_role_ids, priority_role_ids, scheduled_at, ends_at, timezone, private_channel_id, questions_thread_id, waitlist_auto, series_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 RETURNING *;
 
 -- name: FindEventsNeedingH48OrganizerDM :many
@@ -9,7 +9,8 @@ WHERE scheduled_at IS NOT NULL
   AND scheduled_at > $1
   AND scheduled_at - interval '48 hours' <= $1
   AND scheduled_at - interval '47 hours' > $1
-  AND organizer_validation_dm_sent_at IS NULL;
+  AND organizer_validation_dm_sent_at IS NULL
+  AND cancelled_at IS NULL;
 
 -- name: MarkOrganizerValidationDMSent :exec
 UPDATE events SET organizer_validation_dm_sent_at = NOW(), updated_at = NOW() WHERE id = $1;
@@ -23,28 +24,79 @@ SELECT * FROM events WHERE message_id = $1;
 -- name: GetEventByID :one
 SELECT * FROM events WHERE id = $1;
 
+-- name: GetEventByIDForUpdate :one
+SELECT * FROM events WHERE id = $1 FOR UPDATE;
+
+-- name: GetEventsByGuildID :many
+SELECT * FROM events WHERE guild_id = $1 ORDER BY scheduled_at DESC NULLS LAST, id DESC;
+
 -- name: GetEventsByCreatorID :many
-SELECT * FROM events WHERE creator_id = $1 ORDER BY created_at DESC;
+SELECT * FROM events WHERE guild_id = $1 AND creator_id = $2 ORDER BY created_at DESC;
+
+-- name: GetUpcomingEventsByParticipant :many
+SELECT events.* FROM events
+JOIN participants ON participants.event_id = events.id
+WHERE participants.user_id = sqlc.arg(user_id)::text
+  AND participants.status = 'CONFIRMED'
+  AND events.scheduled_at IS NOT NULL
+  AND COALESCE(events.ends_at, events.scheduled_at + interval '2 hours') > sqlc.arg(now)::timestamptz
+  AND events.cancelled_at IS NULL
+ORDER BY events.scheduled_at;
+
+-- name: SetEventFAQMessageID :exec
+UPDATE events SET faq_message_id = $2, updated_at = NOW() WHERE id = $1;
+
+-- name: SetEventScheduledEventID :exec
+UPDATE events SET scheduled_event_id = $2, updated_at = NOW() WHERE id = $1;
+
+-- name: GetEventByScheduledEventID :one
+SELECT * FROM events WHERE scheduled_event_id = $1;
+
+-- name: UpdateEventSchedule :exec
+UPDATE events SET scheduled_at = $2, ends_at = $3, updated_at = NOW() WHERE id = $1;
+
+-- name: CancelEvent :exec
+UPDATE events SET cancelled_at = NOW(), cancel_reason = $2, updated_at = NOW() WHERE id = $1;
 
 -- name: UpdateEvent :exec
 UPDATE events SET
     title = $2,
     description = $3,
-    max_slots = $4,
-    scheduled_at = $5,
-    waitlist_auto = $6,
+    location = $4,
+    max_slots = $5,
+    scheduled_at = $6,
+    ends_at = $7,
+    waitlist_auto = $8,
+    max_guests = $9,
     updated_at = NOW()
 WHERE id = $1;
 
+-- name: UpdateEventRoles :exec
+UPDATE events SET allowed_role_ids = $2, priority_role_ids = $3, updated_at = NOW() WHERE id = $1;
+
 -- name: FindStartedNonFinalizedEvents :many
 SELECT * FROM events
 WHERE scheduled_at IS NOT NULL
   AND scheduled_at <= $1
   AND scheduled_at > $1 - interval '1 hour'
-  AND organizer_step1_finalized_at IS NULL;
+  AND organizer_step1_finalized_at IS NULL
+  AND cancelled_at IS NULL;
+
+-- name: FindRecentlyEndedEvents :many
+SELECT * FROM events
+WHERE scheduled_at IS NOT NULL
+  AND COALESCE(ends_at, scheduled_at + interval '2 hours') <= sqlc.arg(now)::timestamptz
+  AND COALESCE(ends_at, scheduled_at + interval '2 hours') > sqlc.arg(now)::timestamptz - interval '1 hour'
+  AND cancelled_at IS NULL;
 
 -- name: GetEventByPrivateChannelID :one
 SELECT * FROM events WHERE private_channel_id = $1;
 
 -- name: DeleteEvent :exec
 DELETE FROM events WHERE id = $1;
+
+-- name: DeleteEventsByGuildID :exec
+DELETE FROM events WHERE guild_id = $1;
+
+-- name: BackfillEventsGuildID :execrows
+UPDATE events SET guild_id = $1 WHERE guild_id = '';
@@ -0,0 +1,17 @@
+-- name: GetGuildSettings :one
+SELECT * FROM guild_settings WHERE guild_id = $1;
+
+-- name: UpsertGuildSettings :exec
+INSERT INTO guild_settings (guild_id, forum_channel_id, private_category_id, locale, timezone, waitlist_auto, offer_delay_hours)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (guild_id) DO UPDATE SET
+    forum_channel_id = EXCLUDED.forum_channel_id,
+    private_category_id = EXCLUDED.private_category_id,
+    locale = EXCLUDED.locale,
+    timezone = EXCLUDED.timezone,
+    waitlist_auto = EXCLUDED.waitlist_auto,
+    offer_delay_hours = EXCLUDED.offer_delay_hours,
+    updated_at = NOW();
+
+-- name: DeleteGuildSettings :exec
+DELETE FROM guild_settings WHERE guild_id = $1;
@@ -1,6 +1,7 @@
 -- name: CreateParticipant :one
-INSERT INTO participants (event_id, user_id, username, status, joined_at)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO participants (event_id, user_id, username, status, guests, priority, joined_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (event_id, user_id) DO NOTHING
 RETURNING *;
 
 -- name: GetParticipantByID :one
@@ -15,15 +16,46 @@ SELECT * FROM participants WHERE event_id = $1 AND user_id = $2;
 -- name: GetParticipantsByEventIDAndStatus :many
 SELECT * FROM participants WHERE event_id = $1 AND status = $2 ORDER BY created_at ASC;
 
+-- name: GetWaitlistByEventID :many
+SELECT * FROM participants
+WHERE event_id = $1 AND status = 'WAITLIST'
+ORDER BY priority DESC, joined_at ASC, id ASC;
+
 -- name: UpdateParticipant :exec
 UPDATE participants SET
     username = $2,
     status = $3,
+    offer_expires_at = $4,
+    guests = $5,
     updated_at = NOW()
 WHERE id = $1;
 
+-- name: GetUpcomingParticipationsByUserID :many
+SELECT participants.* FROM participants
+JOIN events ON events.id = participants.event_id
+WHERE participants.user_id = sqlc.arg(user_id)::text
+  AND events.guild_id = sqlc.arg(guild_id)::text
+  AND events.cancelled_at IS NULL
+  AND (events.scheduled_at IS NULL
+    OR COALESCE(events.ends_at, events.scheduled_at + interval '2 hours') > sqlc.arg(now)::timestamptz)
+ORDER BY events.scheduled_at NULLS LAST, events.id;
+
+-- name: GetWaitlistRank :one
+SELECT COUNT(*) FROM participants
+WHERE event_id = sqlc.arg(event_id)::bigint
+  AND status = 'WAITLIST'
+  AND (NOT priority, joined_at, id) <= (NOT sqlc.arg(priority)::boolean, sqlc.arg(joined_at)::timestamptz, sqlc.arg(id)::bigint);
+
+-- name: GetExpiredSlotOffers :many
+SELECT * FROM participants
+WHERE status = 'OFFERED' AND offer_expires_at <= sqlc.arg(now)::timestamptz
+ORDER BY offer_expires_at ASC;
+
 -- name: DeleteParticipant :exec
 DELETE FROM participants WHERE id = $1;
 
 -- name: CountParticipantsByEventIDAndStatus :one
 SELECT COUNT(*) FROM participants WHERE event_id = $1 AND status = $2;
+
+-- name: CountSeatsByEventIDAndStatus :one
+SELECT COALESCE(SUM(1 + guests), 0)::bigint FROM participants WHERE event_id = $1 AND status = $2;
@@ -0,0 +1,29 @@
+-- name: CreateQuestion :one
+INSERT INTO questions (event_id, asker_id, asker_name, question)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetQuestionByID :one
+SELECT * FROM questions WHERE id = $1;
+
+-- name: GetQuestionsByEventID :many
+SELECT * FROM questions WHERE event_id = $1 ORDER BY created_at ASC;
+
+-- name: GetUnansweredQuestionsByEventID :many
+SELECT * FROM questions WHERE event_id = $1 AND answered_at IS NULL ORDER BY created_at ASC;
+
+-- name: GetPublishedQuestionsByEventID :many
+SELECT * FROM questions WHERE event_id = $1 AND published_at IS NOT NULL ORDER BY published_at ASC;
+
+-- name: SetQuestionThreadMessageID :exec
+UPDATE questions SET thread_message_id = $2, updated_at = NOW() WHERE id = $1;
+
+-- name: AnswerQuestion :execrows
+UPDATE questions SET
+    answer = sqlc.arg(answer),
+    answerer_id = sqlc.arg(answerer_id),
+    answered_at = NOW(),
+    published_at = CASE WHEN sqlc.arg(publish)::boolean THEN NOW() END,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND answered_at IS NULL;
+
@@ -0,0 +1,19 @@
+-- name: GetUserPreferences :one
+SELECT * FROM user_preferences WHERE user_id = $1;
+
+-- name: UpsertUserPreferences :exec
+INSERT INTO user_preferences (user_id, locale)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE SET
+    locale = EXCLUDED.locale,
+    updated_at = NOW();
+
+-- name: GetUserPreferencesByCalendarToken :one
+SELECT * FROM user_preferences WHERE calendar_token = $1;
+
+-- name: UpsertUserCalendarToken :exec
+INSERT INTO user_preferences (user_id, calendar_token)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE SET
+    calendar_token = EXCLUDED.calendar_token,
+    updated_at = NOW();
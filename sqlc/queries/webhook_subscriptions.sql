@@ -0,0 +1,13 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (guild_id, url, secret)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetWebhookSubscriptionsByGuildID :many
+SELECT * FROM webhook_subscriptions WHERE guild_id = $1 ORDER BY id;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions WHERE id = $1 AND guild_id = $2;
+
+-- name: DeleteWebhookSubscriptionsByGuildID :exec
+DELETE FROM webhook_subscriptions WHERE guild_id = $1;
@@ -1,20 +1,33 @@
 CREATE TABLE events (
     id BIGSERIAL PRIMARY KEY,
+    guild_id TEXT NOT NULL DEFAULT '',
     message_id TEXT NOT NULL,
     channel_id TEXT NOT NULL,
     creator_id TEXT NOT NULL,
     title TEXT NOT NULL,
     description TEXT NOT NULL,
+    location TEXT NOT NULL DEFAULT '',
     max_slots INT NOT NULL DEFAULT 0,
+    max_guests INT NOT NULL DEFAULT 0,
+    allowed_role_ids TEXT[] NOT NULL DEFAULT '{}',
+    priority_role_ids TEXT[] NOT NULL DEFAULT '{}',
     scheduled_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    timezone TEXT NOT NULL DEFAULT 'Europe/Paris',
     private_channel_id TEXT NOT NULL DEFAULT '',
     questions_thread_id TEXT NOT NULL DEFAULT '',
+    faq_message_id TEXT NOT NULL DEFAULT '',
+    scheduled_event_id TEXT NOT NULL DEFAULT '',
     waitlist_auto BOOLEAN NOT NULL DEFAULT TRUE,
     organizer_validation_dm_sent_at TIMESTAMPTZ,
     organizer_step1_finalized_at TIMESTAMPTZ,
+    cancelled_at TIMESTAMPTZ,
+    cancel_reason TEXT NOT NULL DEFAULT '',
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
 
 CREATE INDEX idx_events_message_id ON events(message_id);
 CREATE INDEX idx_events_creator_id ON events(creator_id);
+CREATE INDEX idx_events_guild_id ON events(guild_id);
+CREATE INDEX idx_events_scheduled_event_id ON events(scheduled_event_id);
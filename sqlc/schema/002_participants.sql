@@ -4,11 +4,15 @@ CREATE TABLE participants (
     user_id TEXT NOT NULL,
     username TEXT NOT NULL,
     status TEXT NOT NULL,
+    guests INT NOT NULL DEFAULT 0,
+    priority BOOLEAN NOT NULL DEFAULT FALSE,
     joined_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    offer_expires_at TIMESTAMPTZ,
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
 
 CREATE INDEX idx_participants_event_id ON participants(event_id);
-CREATE INDEX idx_participants_event_id_user_id ON participants(event_id, user_id);
+CREATE UNIQUE INDEX idx_participants_event_id_user_id_unique ON participants(event_id, user_id);
 CREATE INDEX idx_participants_event_id_status ON participants(event_id, status);
+CREATE INDEX idx_participants_status_offer_expires_at ON participants(status, offer_expires_at);
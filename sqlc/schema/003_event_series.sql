@@ -0,0 +1,25 @@
+CREATE TABLE event_series (
+    id BIGSERIAL PRIMARY KEY,
+    guild_id TEXT NOT NULL DEFAULT '',
+    creator_id TEXT NOT NULL,
+    recurrence TEXT NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL,
+    location TEXT NOT NULL DEFAULT '',
+    max_slots INT NOT NULL DEFAULT 0,
+    max_guests INT NOT NULL DEFAULT 0,
+    allowed_role_ids TEXT[] NOT NULL DEFAULT '{}',
+    priority_role_ids TEXT[] NOT NULL DEFAULT '{}',
+    waitlist_auto BOOLEAN NOT NULL DEFAULT TRUE,
+    next_at TIMESTAMPTZ NOT NULL,
+    duration_minutes INT NOT NULL DEFAULT 0,
+    timezone TEXT NOT NULL DEFAULT 'Europe/Paris',
+    anchor_day INT NOT NULL DEFAULT 0,
+    cancelled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE events ADD COLUMN series_id BIGINT REFERENCES event_series(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_events_series_id ON events(series_id);
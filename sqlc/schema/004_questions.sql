@@ -0,0 +1,16 @@
+CREATE TABLE questions (
+    id BIGSERIAL PRIMARY KEY,
+    event_id BIGINT NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    asker_id TEXT NOT NULL,
+    asker_name TEXT NOT NULL,
+    question TEXT NOT NULL,
+    thread_message_id TEXT NOT NULL DEFAULT '',
+    answer TEXT NOT NULL DEFAULT '',
+    answerer_id TEXT NOT NULL DEFAULT '',
+    answered_at TIMESTAMPTZ,
+    published_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_questions_event_id ON questions(event_id);
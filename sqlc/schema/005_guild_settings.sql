@@ -0,0 +1,11 @@
+CREATE TABLE guild_settings (
+    guild_id TEXT PRIMARY KEY,
+    forum_channel_id TEXT NOT NULL DEFAULT '',
+    private_category_id TEXT NOT NULL DEFAULT '',
+    locale TEXT NOT NULL DEFAULT 'fr',
+    timezone TEXT NOT NULL DEFAULT 'Europe/Paris',
+    waitlist_auto BOOLEAN NOT NULL DEFAULT TRUE,
+    offer_delay_hours INTEGER NOT NULL DEFAULT 12,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,9 @@
+CREATE TABLE user_preferences (
+    user_id TEXT PRIMARY KEY,
+    locale TEXT NOT NULL DEFAULT '',
+    calendar_token TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_user_preferences_calendar_token ON user_preferences(calendar_token) WHERE calendar_token <> '';